@@ -0,0 +1,53 @@
+package dates
+
+import "time"
+
+// Convention is an ISDA business day convention for adjusting dates that fall on a weekend or holiday
+type Convention int
+
+const (
+	Unadjusted        Convention = iota // the date is not adjusted
+	Following                           // the next business day
+	ModifiedFollowing                   // the next business day unless it is in the next month, then the previous business day
+	Preceding                           // the previous business day
+	ModifiedPreceding                   // the previous business day unless it is in the previous month, then the next business day
+	Nearest                             // the closest business day, the next business day when both are as close
+)
+
+// Adjust returns the day of t moved to a business day of the calendar by the convention,
+// t is returned as is when it is a business day
+func Adjust(t time.Time, conv Convention, cal Calendar) time.Time {
+	t = Day(t)
+	if conv == Unadjusted || cal.IsBusinessDay(t) {
+		return t
+	}
+	switch conv {
+	case Following:
+		return cal.NextBusinessDay(t)
+	case ModifiedFollowing:
+		if next := cal.NextBusinessDay(t); next.Month() == t.Month() {
+			return next
+		}
+		return cal.PrevBusinessDay(t)
+	case Preceding:
+		return cal.PrevBusinessDay(t)
+	case ModifiedPreceding:
+		if prev := cal.PrevBusinessDay(t); prev.Month() == t.Month() {
+			return prev
+		}
+		return cal.NextBusinessDay(t)
+	case Nearest:
+		next, prev := cal.NextBusinessDay(t), cal.PrevBusinessDay(t)
+		if next.Sub(t) <= t.Sub(prev) {
+			return next
+		}
+		return prev
+	}
+	return t
+}
+
+// Settlement returns the T+n settlement date of a trade on t, the number of business days after
+// the trade date. A trade on a weekend or holiday is traded on the following business day.
+func Settlement(t time.Time, n int, cal Calendar) time.Time {
+	return cal.AddBusinessDays(Adjust(t, Following, cal), n)
+}
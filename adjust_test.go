@@ -0,0 +1,67 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestAdjust(t *testing.T) {
+	type input struct {
+		t    time.Time
+		conv Convention
+	}
+	fn := func(in input) (time.Time, error) {
+		return Adjust(in.t, in.conv, USFederal), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"business day": {Input: input{Date(2024, 3, 15), ModifiedFollowing}, Expected: Date(2024, 3, 15)},
+		"unadjusted":   {Input: input{Date(2024, 3, 16), Unadjusted}, Expected: Date(2024, 3, 16)},
+		"following":    {Input: input{Date(2024, 3, 30), Following}, Expected: Date(2024, 4, 1)},
+		"modified following": {
+			Input:    input{Date(2024, 3, 30), ModifiedFollowing},
+			Expected: Date(2024, 3, 29),
+		},
+		"modified following same month": {
+			Input:    input{Date(2024, 3, 16), ModifiedFollowing},
+			Expected: Date(2024, 3, 18),
+		},
+		"following holiday": {Input: input{Date(2024, 1, 13), Following}, Expected: Date(2024, 1, 16)},
+		"preceding":         {Input: input{Date(2024, 6, 1), Preceding}, Expected: Date(2024, 5, 31)},
+		"modified preceding": {
+			Input:    input{Date(2024, 6, 1), ModifiedPreceding},
+			Expected: Date(2024, 6, 3),
+		},
+		"modified preceding same month": {
+			Input:    input{Date(2024, 6, 16), ModifiedPreceding},
+			Expected: Date(2024, 6, 14),
+		},
+		"nearest saturday": {Input: input{Date(2024, 3, 16), Nearest}, Expected: Date(2024, 3, 15)},
+		"nearest sunday":   {Input: input{Date(2024, 3, 17), Nearest}, Expected: Date(2024, 3, 18)},
+		"nearest tie":      {Input: input{Date(2024, 1, 14), Nearest}, Expected: Date(2024, 1, 16)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestSettlement(t *testing.T) {
+	type input struct {
+		t time.Time
+		n int
+	}
+	fn := func(in input) (time.Time, error) {
+		return Settlement(in.t, in.n, USFederal), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"T+0":          {Input: input{Date(2024, 5, 28), 0}, Expected: Date(2024, 5, 28)},
+		"T+1":          {Input: input{Date(2024, 5, 31), 1}, Expected: Date(2024, 6, 3)},
+		"T+2 holiday":  {Input: input{Date(2024, 7, 3), 2}, Expected: Date(2024, 7, 8)},
+		"T+2 weekend":  {Input: input{Date(2024, 7, 6), 2}, Expected: Date(2024, 7, 10)},
+		"T+0 saturday": {Input: input{Date(2024, 7, 6), 0}, Expected: Date(2024, 7, 8)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
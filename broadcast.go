@@ -0,0 +1,78 @@
+package dates
+
+import "time"
+
+// The broadcast calendar used by media companies has Monday to Sunday weeks.
+// A broadcast month starts on the Monday of the week containing the 1st of the month
+// and ends on the last Sunday of the month, so every broadcast month has 4 or 5 whole weeks.
+
+var broadcastWeek = Week{weekStart: time.Monday, weekEnd: time.Sunday}
+
+// broadcastMonthStart returns the first day of the broadcast month
+func broadcastMonthStart(year int, month time.Month) time.Time {
+	return broadcastWeek.StartOfWeek(Date(year, month, 1))
+}
+
+// BroadcastMonth returns the broadcast year and month of t,
+// the calendar month of the Sunday that ends t's week
+func BroadcastMonth(t time.Time) (year int, month time.Month) {
+	sunday := broadcastWeek.StartOfWeek(t).AddDate(0, 0, 6)
+	return sunday.Year(), sunday.Month()
+}
+
+// FullBroadcastMonth returns the start and end dates of t's broadcast month
+func FullBroadcastMonth(t time.Time) (start, end time.Time) {
+	year, month := BroadcastMonth(t)
+	return broadcastMonthStart(year, month), broadcastMonthStart(year, month+1).Add(-OneDay)
+}
+
+// BroadcastQuarter returns the broadcast quarter (1-4) of t
+func BroadcastQuarter(t time.Time) int {
+	_, month := BroadcastMonth(t)
+	return (int(month)-1)/3 + 1
+}
+
+// FullBroadcastQuarter returns the start and end dates of t's broadcast quarter
+func FullBroadcastQuarter(t time.Time) (start, end time.Time) {
+	year, _ := BroadcastMonth(t)
+	first := time.Month((BroadcastQuarter(t)-1)*3 + 1)
+	return broadcastMonthStart(year, first), broadcastMonthStart(year, first+3).Add(-OneDay)
+}
+
+// FullBroadcastYear returns the start and end dates of t's broadcast year
+func FullBroadcastYear(t time.Time) (start, end time.Time) {
+	year, _ := BroadcastMonth(t)
+	return broadcastMonthStart(year, time.January), broadcastMonthStart(year+1, time.January).Add(-OneDay)
+}
+
+// BroadcastWeekNumber returns the broadcast year and week of t, week 1 contains Jan 1st
+func BroadcastWeekNumber(t time.Time) (year, week int) {
+	year, _ = BroadcastMonth(t)
+	return year, DaysBetween(broadcastMonthStart(year, time.January), broadcastWeek.StartOfWeek(t))/7 + 1
+}
+
+// BroadcastMonthToDate returns the start of t's broadcast month to t
+func BroadcastMonthToDate(t time.Time) (start, end time.Time) {
+	start, _ = FullBroadcastMonth(t)
+	return start, Day(t)
+}
+
+// PrevBroadcastMonth returns the start and end dates of the broadcast month before t's broadcast month
+func PrevBroadcastMonth(t time.Time) (start, end time.Time) {
+	start, _ = FullBroadcastMonth(t)
+	return FullBroadcastMonth(start.Add(-OneDay))
+}
+
+// PrevYearBroadcastMtd returns the same broadcast month of the previous year to the same day of the month,
+// the end is the same number of days from the start of the month (the same weekday) clamped to the end of the month
+func PrevYearBroadcastMtd(t time.Time) (start, end time.Time) {
+	year, month := BroadcastMonth(t)
+	mtdStart, _ := FullBroadcastMonth(t)
+	start = broadcastMonthStart(year-1, month)
+	last := broadcastMonthStart(year-1, month+1).Add(-OneDay)
+	end = start.AddDate(0, 0, DaysBetween(mtdStart, t))
+	if end.After(last) {
+		end = last
+	}
+	return start, end
+}
@@ -0,0 +1,87 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestFullBroadcastMonth(t *testing.T) {
+	fn := func(in time.Time) (output, error) {
+		start, end := FullBroadcastMonth(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"january 2024": {Input: Date(2024, 1, 15), Expected: output{Date(2024, 1, 1), Date(2024, 1, 28)}},
+		"end of month in next": {
+			Input:    Date(2024, 1, 30),
+			Expected: output{Date(2024, 1, 29), Date(2024, 2, 25)},
+		},
+		"starts in previous year": {
+			Input:    Date(2025, 1, 1),
+			Expected: output{Date(2024, 12, 30), Date(2025, 1, 26)},
+		},
+		"december": {Input: Date(2024, 12, 29), Expected: output{Date(2024, 11, 25), Date(2024, 12, 29)}},
+		"5 weeks":  {Input: Date(2024, 3, 31), Expected: output{Date(2024, 2, 26), Date(2024, 3, 31)}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFullBroadcastQuarterYear(t *testing.T) {
+	fn := func(in func(time.Time) (time.Time, time.Time)) (output, error) {
+		start, end := in(Date(2024, 5, 1))
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[func(time.Time) (time.Time, time.Time), output]{
+		"quarter":       {Input: FullBroadcastQuarter, Expected: output{Date(2024, 4, 1), Date(2024, 6, 30)}},
+		"year":          {Input: FullBroadcastYear, Expected: output{Date(2024, 1, 1), Date(2024, 12, 29)}},
+		"month to date": {Input: BroadcastMonthToDate, Expected: output{Date(2024, 4, 29), Date(2024, 5, 1)}},
+		"prev month":    {Input: PrevBroadcastMonth, Expected: output{Date(2024, 4, 1), Date(2024, 4, 28)}},
+		"prev year mtd": {Input: PrevYearBroadcastMtd, Expected: output{Date(2023, 5, 1), Date(2023, 5, 3)}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestBroadcastWeekNumber(t *testing.T) {
+	fn := func(in time.Time) ([2]int, error) {
+		year, week := BroadcastWeekNumber(in)
+		return [2]int{year, week}, nil
+	}
+
+	cases := trial.Cases[time.Time, [2]int]{
+		"first":         {Input: Date(2024, 1, 1), Expected: [2]int{2024, 1}},
+		"last":          {Input: Date(2024, 12, 29), Expected: [2]int{2024, 52}},
+		"next year":     {Input: Date(2024, 12, 30), Expected: [2]int{2025, 1}},
+		"53 week year":  {Input: Date(2017, 12, 31), Expected: [2]int{2017, 53}},
+		"jan 1 on sun":  {Input: Date(2023, 1, 1), Expected: [2]int{2023, 1}},
+		"after jan 1st": {Input: Date(2023, 1, 2), Expected: [2]int{2023, 2}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPrevYearBroadcastMtd(t *testing.T) {
+	fn := func(in time.Time) (output, error) {
+		start, end := PrevYearBroadcastMtd(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"same weekday": {Input: Date(2024, 2, 14), Expected: output{Date(2023, 1, 30), Date(2023, 2, 15)}},
+		"clamped to 4 weeks": {
+			Input:    Date(2024, 3, 31),
+			Expected: output{Date(2023, 2, 27), Date(2023, 3, 26)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if q := BroadcastQuarter(Date(2024, 3, 31)); q != 1 {
+		t.Errorf("quarter got %d", q)
+	}
+}
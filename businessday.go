@@ -0,0 +1,73 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoBusinessDay is returned when a period does not have the requested business day
+var ErrNoBusinessDay = errors.New("no business day")
+
+// NthBusinessDay returns the nth business day of the period, a negative n counts back from the end
+// i.e., 3 is the 3rd business day and -1 the last. An error wrapping ErrNoBusinessDay is returned
+// when n is 0 or the period has fewer business days.
+func NthBusinessDay(period Range, n int, cal Calendar) (time.Time, error) {
+	start, end := Day(period.Start), Day(period.End)
+	t, step, count := start, 1, n
+	if n < 0 {
+		t, step, count = end, -1, -n
+	}
+	for count > 0 && !t.Before(start) && !t.After(end) {
+		if cal.IsBusinessDay(t) {
+			if count--; count == 0 {
+				return t, nil
+			}
+		}
+		t = t.AddDate(0, 0, step)
+	}
+	return time.Time{}, fmt.Errorf("business day %d of %s: %w", n, period, ErrNoBusinessDay)
+}
+
+// BusinessDayOfMonth returns the ordinal of the business day t in its month,
+// i.e., 1 for the first business day, 0 is returned if t is not a business day
+func BusinessDayOfMonth(t time.Time, cal Calendar) int {
+	t = Day(t)
+	if !cal.IsBusinessDay(t) {
+		return 0
+	}
+	return cal.BusinessDays(NewRange(StartOfMonth(t), t))
+}
+
+// NthBusinessDayOfMonth returns the nth business day of t's month, see NthBusinessDay
+func NthBusinessDayOfMonth(t time.Time, n int, cal Calendar) (time.Time, error) {
+	return NthBusinessDay(NewRange(StartOfMonth(t), LastDayOfMonth(t)), n, cal)
+}
+
+// LastBusinessDayOfMonth returns the last business day of t's month
+func LastBusinessDayOfMonth(t time.Time, cal Calendar) time.Time {
+	d, _ := NthBusinessDayOfMonth(t, -1, cal)
+	return d
+}
+
+// NthBusinessDayOfQuarter returns the nth business day of t's quarter, see NthBusinessDay
+func NthBusinessDayOfQuarter(t time.Time, n int, cal Calendar) (time.Time, error) {
+	return NthBusinessDay(NewRange(FullQuarter(t)), n, cal)
+}
+
+// LastBusinessDayOfQuarter returns the last business day of t's quarter
+func LastBusinessDayOfQuarter(t time.Time, cal Calendar) time.Time {
+	d, _ := NthBusinessDayOfQuarter(t, -1, cal)
+	return d
+}
+
+// NthBusinessDayOfYear returns the nth business day of t's year, see NthBusinessDay
+func NthBusinessDayOfYear(t time.Time, n int, cal Calendar) (time.Time, error) {
+	return NthBusinessDay(NewRange(FullYear(t)), n, cal)
+}
+
+// LastBusinessDayOfYear returns the last business day of t's year
+func LastBusinessDayOfYear(t time.Time, cal Calendar) time.Time {
+	d, _ := NthBusinessDayOfYear(t, -1, cal)
+	return d
+}
@@ -0,0 +1,86 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestNthBusinessDay(t *testing.T) {
+	type input struct {
+		period Range
+		n      int
+	}
+	fn := func(in input) (time.Time, error) {
+		return NthBusinessDay(in.period, in.n, USFederal)
+	}
+
+	jan := NewRange(FullMonth(Date(2024, 1, 1)))
+	cases := trial.Cases[input, time.Time]{
+		"first":          {Input: input{jan, 1}, Expected: Date(2024, 1, 2)},
+		"third":          {Input: input{jan, 3}, Expected: Date(2024, 1, 4)},
+		"skip mlk":       {Input: input{jan, 10}, Expected: Date(2024, 1, 16)},
+		"last":           {Input: input{jan, -1}, Expected: Date(2024, 1, 31)},
+		"second to last": {Input: input{NewRange(FullMonth(Date(2024, 3, 1))), -2}, Expected: Date(2024, 3, 28)},
+		"zero":           {Input: input{jan, 0}, ExpectedErr: ErrNoBusinessDay},
+		"too many":       {Input: input{jan, 22}, ExpectedErr: ErrNoBusinessDay},
+		"weekend":        {Input: input{NewRange(Date(2024, 1, 6), Date(2024, 1, 7)), 1}, ExpectedErr: ErrNoBusinessDay},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestBusinessDayOfMonth(t *testing.T) {
+	fn := func(in time.Time) (int, error) {
+		return BusinessDayOfMonth(in, USFederal), nil
+	}
+
+	cases := trial.Cases[time.Time, int]{
+		"holiday":   {Input: Date(2024, 1, 1), Expected: 0},
+		"first":     {Input: Date(2024, 1, 2), Expected: 1},
+		"weekend":   {Input: Date(2024, 1, 6), Expected: 0},
+		"after":     {Input: Date(2024, 1, 16), Expected: 10},
+		"with time": {Input: time.Date(2024, 2, 1, 15, 0, 0, 0, time.UTC), Expected: 1},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPeriodBusinessDays(t *testing.T) {
+	fn := func(in func() (time.Time, error)) (time.Time, error) {
+		return in()
+	}
+	last := func(fn func(time.Time, Calendar) time.Time, t time.Time) func() (time.Time, error) {
+		return func() (time.Time, error) { return fn(t, USFederal), nil }
+	}
+
+	cases := trial.Cases[func() (time.Time, error), time.Time]{
+		"3rd of month": {
+			Input:    func() (time.Time, error) { return NthBusinessDayOfMonth(Date(2024, 9, 20), 3, USFederal) },
+			Expected: Date(2024, 9, 5),
+		},
+		"last of month": {
+			Input:    last(LastBusinessDayOfMonth, Date(2024, 8, 10)),
+			Expected: Date(2024, 8, 30),
+		},
+		"1st of quarter": {
+			Input:    func() (time.Time, error) { return NthBusinessDayOfQuarter(Date(2024, 8, 10), 1, USFederal) },
+			Expected: Date(2024, 7, 1),
+		},
+		"last of quarter": {
+			Input:    last(LastBusinessDayOfQuarter, Date(2024, 2, 10)),
+			Expected: Date(2024, 3, 29),
+		},
+		"1st of year": {
+			Input:    func() (time.Time, error) { return NthBusinessDayOfYear(Date(2023, 6, 1), 1, USFederal) },
+			Expected: Date(2023, 1, 3),
+		},
+		"last of year": {
+			Input:    last(LastBusinessDayOfYear, Date(2022, 6, 1)),
+			Expected: Date(2022, 12, 30),
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
@@ -0,0 +1,215 @@
+package dates
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HolidayFunc returns the date of a holiday in the year of the given date,
+// the zero time is returned if there is no holiday that year.
+// A holiday that can fall more than once a year, like the Hijri holidays, returns the first
+// date on or after the given date and a Calendar asks again from the day after for the next one.
+type HolidayFunc func(date time.Time) time.Time
+
+// Holiday is a named holiday of a Calendar
+type Holiday struct {
+	Name string
+	Date HolidayFunc
+}
+
+// dates returns the days of the holiday for the year, usually one.
+// The days may be in the year before or after when the holiday is observed on another day.
+func (h Holiday) dates(year int) []time.Time {
+	t := h.Date(Date(year, time.January, 1))
+	if t.IsZero() {
+		return nil
+	}
+	dates := []time.Time{Day(t)}
+	for {
+		from := Day(t).AddDate(0, 0, 1)
+		if from.Year() != year {
+			return dates
+		}
+		next := h.Date(from)
+		if next.IsZero() || !Day(next).After(Day(t)) {
+			return dates
+		}
+		dates = append(dates, Day(next))
+		t = next
+	}
+}
+
+// HolidayDate is a holiday on a specific date
+type HolidayDate struct {
+	Name string
+	Date time.Time
+}
+
+// Calendar is a set of holidays and weekend days used for business day calculations
+type Calendar struct {
+	Name     string
+	Holidays []Holiday
+	Weekend  []time.Weekday // Saturday and Sunday when empty
+}
+
+// NewCalendar returns a calendar with the given holidays and a Saturday and Sunday weekend
+func NewCalendar(name string, holidays ...Holiday) Calendar {
+	return Calendar{Name: name, Holidays: holidays}
+}
+
+// USFederal is the calendar of US federal holidays on the days they are observed
+var USFederal = NewCalendar("us",
+	Holiday{"New Year's Day", Observed(NewYearsDay)},
+	Holiday{"Martin Luther King Jr. Day", MartinLutherKingJrDay},
+	Holiday{"Washington's Birthday", WashingtonsBirthday},
+	Holiday{"Memorial Day", MemorialDay},
+	Holiday{"Juneteenth", Observed(Since(2021, Juneteenth))},
+	Holiday{"Independence Day", Observed(IndependenceDay)},
+	Holiday{"Labor Day", LaborDay},
+	Holiday{"Columbus Day", ColumbusDay},
+	Holiday{"Veterans Day", Observed(VeteransDay)},
+	Holiday{"Thanksgiving Day", ThanksgivingDay},
+	Holiday{"Christmas Day", Observed(ChristmasDay)},
+)
+
+// HolidaysIn returns the holidays that fall in the year sorted by date.
+// Holidays of the previous and next year that are observed in the year are included
+func (c Calendar) HolidaysIn(year int) []HolidayDate {
+	var dates []HolidayDate
+	for _, h := range c.Holidays {
+		for y := year - 1; y <= year+1; y++ {
+			for _, t := range h.dates(y) {
+				if t.Year() == year {
+					dates = append(dates, HolidayDate{Name: h.Name, Date: t})
+				}
+			}
+		}
+	}
+	sort.SliceStable(dates, func(i, j int) bool {
+		return dates[i].Date.Before(dates[j].Date)
+	})
+	return dates
+}
+
+// Holiday returns the name of the holiday on the day of t
+func (c Calendar) Holiday(t time.Time) (string, bool) {
+	t = Day(t)
+	for _, h := range c.Holidays {
+		for y := t.Year() - 1; y <= t.Year()+1; y++ {
+			if slices.ContainsFunc(h.dates(y), t.Equal) {
+				return h.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// IsHoliday reports whether t is a holiday
+func (c Calendar) IsHoliday(t time.Time) bool {
+	_, ok := c.Holiday(t)
+	return ok
+}
+
+// IsWeekend reports whether t is a weekend day
+func (c Calendar) IsWeekend(t time.Time) bool {
+	if len(c.Weekend) == 0 {
+		return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+	}
+	return slices.Contains(c.Weekend, t.Weekday())
+}
+
+// IsBusinessDay reports whether t is not a weekend day or a holiday
+func (c Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// NextBusinessDay returns the first business day after t
+func (c Calendar) NextBusinessDay(t time.Time) time.Time {
+	t = Day(t).Add(OneDay)
+	for !c.IsBusinessDay(t) {
+		t = t.Add(OneDay)
+	}
+	return t
+}
+
+// PrevBusinessDay returns the last business day before t
+func (c Calendar) PrevBusinessDay(t time.Time) time.Time {
+	t = Day(t).Add(-OneDay)
+	for !c.IsBusinessDay(t) {
+		t = t.Add(-OneDay)
+	}
+	return t
+}
+
+// AddBusinessDays returns t with n business days added (use negative value to subtract)
+func (c Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	t = Day(t)
+	for ; n > 0; n-- {
+		t = c.NextBusinessDay(t)
+	}
+	for ; n < 0; n++ {
+		t = c.PrevBusinessDay(t)
+	}
+	return t
+}
+
+// BusinessDays returns the number of business days in the range including the start and end dates
+func (c Calendar) BusinessDays(r Range) int {
+	start, end := Day(r.Start), Day(r.End)
+	count := 0
+	for t := start; !t.After(end); t = t.AddDate(0, 0, 1) {
+		if !c.IsWeekend(t) {
+			count++
+		}
+	}
+	// holidays are looked up once a year instead of for every day
+	holidays := make(map[time.Time]bool)
+	for year := start.Year(); year <= end.Year(); year++ {
+		for _, h := range c.HolidaysIn(year) {
+			if h.Date.Before(start) || h.Date.After(end) || c.IsWeekend(h.Date) || holidays[h.Date] {
+				continue
+			}
+			holidays[h.Date] = true
+			count--
+		}
+	}
+	return count
+}
+
+var (
+	calendarsMu sync.RWMutex
+	calendars   = map[string]Calendar{
+		USFederal.Name: USFederal,
+	}
+)
+
+// RegisterCalendar adds a calendar that can be found by its name with LookupCalendar,
+// a calendar with the same name is replaced
+func RegisterCalendar(c Calendar) {
+	calendarsMu.Lock()
+	defer calendarsMu.Unlock()
+	calendars[strings.ToLower(c.Name)] = c
+}
+
+// LookupCalendar returns the registered calendar by name without case, i.e., "us"
+func LookupCalendar(name string) (Calendar, bool) {
+	calendarsMu.RLock()
+	defer calendarsMu.RUnlock()
+	c, ok := calendars[strings.ToLower(name)]
+	return c, ok
+}
+
+// CalendarNames returns the names of the registered calendars sorted
+func CalendarNames() []string {
+	calendarsMu.RLock()
+	defer calendarsMu.RUnlock()
+	names := make([]string, 0, len(calendars))
+	for name := range calendars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,136 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestHolidaysIn(t *testing.T) {
+	fn := func(in int) ([]HolidayDate, error) {
+		return USFederal.HolidaysIn(in), nil
+	}
+
+	cases := trial.Cases[int, []HolidayDate]{
+		"2021 includes observed new years of 2022": {
+			Input: 2021,
+			Expected: []HolidayDate{
+				{"New Year's Day", Date(2021, 1, 1)},
+				{"Martin Luther King Jr. Day", Date(2021, 1, 18)},
+				{"Washington's Birthday", Date(2021, 2, 15)},
+				{"Memorial Day", Date(2021, 5, 31)},
+				{"Juneteenth", Date(2021, 6, 18)},
+				{"Independence Day", Date(2021, 7, 5)},
+				{"Labor Day", Date(2021, 9, 6)},
+				{"Columbus Day", Date(2021, 10, 11)},
+				{"Veterans Day", Date(2021, 11, 11)},
+				{"Thanksgiving Day", Date(2021, 11, 25)},
+				{"Christmas Day", Date(2021, 12, 24)},
+				{"New Year's Day", Date(2021, 12, 31)},
+			},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestCalendarHoliday(t *testing.T) {
+	type result struct {
+		name string
+		ok   bool
+	}
+	fn := func(in time.Time) (result, error) {
+		name, ok := USFederal.Holiday(in)
+		return result{name, ok}, nil
+	}
+
+	cases := trial.Cases[time.Time, result]{
+		"holiday":             {Input: Date(2024, 11, 28), Expected: result{"Thanksgiving Day", true}},
+		"observed":            {Input: Date(2021, 12, 31), Expected: result{"New Year's Day", true}},
+		"actual not observed": {Input: Date(2022, 1, 1), Expected: result{"", false}},
+		"before juneteenth":   {Input: Date(2020, 6, 19), Expected: result{"", false}},
+		"normal day":          {Input: Date(2024, 11, 27), Expected: result{"", false}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	fn := func(in time.Time) (bool, error) {
+		return USFederal.IsBusinessDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, bool]{
+		"weekday":  {Input: Date(2024, 2, 6), Expected: true},
+		"saturday": {Input: Date(2024, 2, 3), Expected: false},
+		"holiday":  {Input: Date(2024, 1, 15), Expected: false},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestIsWeekend(t *testing.T) {
+	c := Calendar{Weekend: []time.Weekday{time.Friday, time.Saturday}}
+	fn := func(in time.Time) (bool, error) {
+		return c.IsWeekend(in), nil
+	}
+
+	cases := trial.Cases[time.Time, bool]{
+		"friday": {Input: Date(2024, 2, 2), Expected: true},
+		"sunday": {Input: Date(2024, 2, 4), Expected: false},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	type input struct {
+		date time.Time
+		days int
+	}
+	fn := func(in input) (time.Time, error) {
+		return USFederal.AddBusinessDays(in.date, in.days), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"over weekend": {Input: input{Date(2024, 2, 2), 1}, Expected: Date(2024, 2, 5)},
+		"over holiday": {Input: input{Date(2024, 1, 12), 1}, Expected: Date(2024, 1, 16)},
+		"backwards":    {Input: input{Date(2024, 1, 16), -2}, Expected: Date(2024, 1, 11)},
+		"zero":         {Input: input{Date(2024, 1, 13), 0}, Expected: Date(2024, 1, 13)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestBusinessDays(t *testing.T) {
+	fn := func(in Range) (int, error) {
+		return USFederal.BusinessDays(in), nil
+	}
+
+	cases := trial.Cases[Range, int]{
+		"first quarter": {Input: NewRange(FullQuarter(Date(2024, 1, 1))), Expected: 62},
+		"weekend":       {Input: NewRange(Date(2024, 2, 3), Date(2024, 2, 4)), Expected: 0},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestLookupCalendar(t *testing.T) {
+	RegisterCalendar(NewCalendar("Test", Holiday{"New Year's Day", NewYearsDay}))
+	fn := func(in string) (string, error) {
+		c, ok := LookupCalendar(in)
+		if !ok {
+			return "", nil
+		}
+		return c.Name, nil
+	}
+
+	cases := trial.Cases[string, string]{
+		"us":         {Input: "US", Expected: "us"},
+		"registered": {Input: "test", Expected: "Test"},
+		"unknown":    {Input: "mars", Expected: ""},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
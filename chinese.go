@@ -0,0 +1,262 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrLunarRange is returned for dates outside of the Chinese lunar years 1900 to 2100
+var ErrLunarRange = errors.New("date outside of the lunar years 1900 to 2100")
+
+// ChineseDate is a date in the Chinese lunisolar calendar
+type ChineseDate struct {
+	Year  int
+	Month int  // 1 to 12
+	Day   int  // 1 to 30
+	Leap  bool // the leap month that follows Month
+}
+
+// lunarInfo describes the lunar years 1900 to 2100 following the Hong Kong Observatory tables.
+// Bits 15 to 4 are months 1 to 12 with 30 days when set and 29 otherwise,
+// bits 3 to 0 are the leap month (0 for none) and bit 16 is set when the leap month has 30 days.
+var lunarInfo = [...]uint32{
+	0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900
+	0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977, // 1910
+	0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970, // 1920
+	0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950, // 1930
+	0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557, // 1940
+	0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0, // 1950
+	0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0, // 1960
+	0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6, // 1970
+	0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570, // 1980
+	0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0, // 1990
+	0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5, // 2000
+	0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930, // 2010
+	0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530, // 2020
+	0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45, // 2030
+	0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0, // 2040
+	0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06b20, 0x1a6c4, 0x0aae0, // 2050
+	0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4, // 2060
+	0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0, // 2070
+	0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160, // 2080
+	0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252, // 2090
+	0x0d520, // 2100
+}
+
+const lunarFirstYear = 1900
+
+// lunarEpoch is the Chinese new year of 1900
+var lunarEpoch = Date(1900, time.January, 31)
+
+// lunarLeapMonth returns the leap month of the lunar year, 0 when there is none
+func lunarLeapMonth(year int) int {
+	return int(lunarInfo[year-lunarFirstYear] & 0xf)
+}
+
+// lunarMonthDays returns the days in the month of the lunar year
+func lunarMonthDays(year, month int, leap bool) int {
+	info := lunarInfo[year-lunarFirstYear]
+	if leap {
+		if info&0x10000 != 0 {
+			return 30
+		}
+		return 29
+	}
+	if info&(0x10000>>uint(month)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// lunarYearDays returns the days in the lunar year
+func lunarYearDays(year int) int {
+	days := 0
+	for m := 1; m <= 12; m++ {
+		days += lunarMonthDays(year, m, false)
+		if m == lunarLeapMonth(year) {
+			days += lunarMonthDays(year, m, true)
+		}
+	}
+	return days
+}
+
+// ToChinese returns the Chinese lunisolar date of t
+func ToChinese(t time.Time) (ChineseDate, error) {
+	days := JulianDayNumber(t) - JulianDayNumber(lunarEpoch)
+	if days < 0 {
+		return ChineseDate{}, fmt.Errorf("%s: %w", t.Format(time.DateOnly), ErrLunarRange)
+	}
+	for year := lunarFirstYear; year < lunarFirstYear+len(lunarInfo); year++ {
+		if n := lunarYearDays(year); days >= n {
+			days -= n
+			continue
+		}
+		for m := 1; m <= 12; m++ {
+			if n := lunarMonthDays(year, m, false); days >= n {
+				days -= n
+			} else {
+				return ChineseDate{Year: year, Month: m, Day: days + 1}, nil
+			}
+			if m != lunarLeapMonth(year) {
+				continue
+			}
+			if n := lunarMonthDays(year, m, true); days >= n {
+				days -= n
+			} else {
+				return ChineseDate{Year: year, Month: m, Day: days + 1, Leap: true}, nil
+			}
+		}
+	}
+	return ChineseDate{}, fmt.Errorf("%s: %w", t.Format(time.DateOnly), ErrLunarRange)
+}
+
+// FromChinese returns the Gregorian date of the Chinese lunisolar date c.
+// An error is returned for a leap month that does not exist or a day past the end of the month.
+func FromChinese(c ChineseDate) (time.Time, error) {
+	if c.Year < lunarFirstYear || c.Year >= lunarFirstYear+len(lunarInfo) {
+		return time.Time{}, fmt.Errorf("lunar year %d: %w", c.Year, ErrLunarRange)
+	}
+	if c.Month < 1 || c.Month > 12 || (c.Leap && lunarLeapMonth(c.Year) != c.Month) {
+		return time.Time{}, fmt.Errorf("invalid lunar month %d (leap %v) of %d", c.Month, c.Leap, c.Year)
+	}
+	if c.Day < 1 || c.Day > lunarMonthDays(c.Year, c.Month, c.Leap) {
+		return time.Time{}, fmt.Errorf("invalid lunar day %d of month %d", c.Day, c.Month)
+	}
+
+	days := c.Day - 1
+	for y := lunarFirstYear; y < c.Year; y++ {
+		days += lunarYearDays(y)
+	}
+	for m := 1; m < c.Month; m++ {
+		days += lunarMonthDays(c.Year, m, false)
+		if m == lunarLeapMonth(c.Year) {
+			days += lunarMonthDays(c.Year, m, true)
+		}
+	}
+	if c.Leap {
+		days += lunarMonthDays(c.Year, c.Month, false)
+	}
+	return lunarEpoch.AddDate(0, 0, days), nil
+}
+
+// SolarTerm is one of the 24 solar terms of the Chinese calendar in the order they fall in a year
+type SolarTerm int
+
+const (
+	XiaoHan     SolarTerm = iota // Minor Cold, 285°
+	DaHan                        // Major Cold
+	LiChun                       // Start of Spring
+	YuShui                       // Rain Water
+	JingZhe                      // Awakening of Insects
+	ChunFen                      // Spring Equinox, 0°
+	QingMing                     // Pure Brightness
+	GuYu                         // Grain Rain
+	LiXia                        // Start of Summer
+	XiaoMan                      // Grain Full
+	MangZhong                    // Grain in Ear
+	XiaZhi                       // Summer Solstice
+	XiaoShu                      // Minor Heat
+	DaShu                        // Major Heat
+	LiQiu                        // Start of Autumn
+	ChuShu                       // End of Heat
+	BaiLu                        // White Dew
+	QiuFen                       // Autumn Equinox
+	HanLu                        // Cold Dew
+	ShuangJiang                  // Frost's Descent
+	LiDong                       // Start of Winter
+	XiaoXue                      // Minor Snow
+	DaXue                        // Major Snow
+	DongZhi                      // Winter Solstice
+)
+
+var solarTerms = [24]string{
+	"Minor Cold", "Major Cold", "Start of Spring", "Rain Water", "Awakening of Insects", "Spring Equinox",
+	"Pure Brightness", "Grain Rain", "Start of Summer", "Grain Full", "Grain in Ear", "Summer Solstice",
+	"Minor Heat", "Major Heat", "Start of Autumn", "End of Heat", "White Dew", "Autumn Equinox",
+	"Cold Dew", "Frost's Descent", "Start of Winter", "Minor Snow", "Major Snow", "Winter Solstice",
+}
+
+// String returns the English name of the solar term
+func (s SolarTerm) String() string {
+	if s < XiaoHan || s > DongZhi {
+		return fmt.Sprintf("%%!SolarTerm(%d)", int(s))
+	}
+	return solarTerms[s]
+}
+
+// Longitude returns the apparent longitude of the sun in degrees at the start of the solar term
+func (s SolarTerm) Longitude() float64 {
+	return math.Mod(285+15*float64(s), 360)
+}
+
+// chinaTime is China Standard Time that the Chinese calendar is reckoned in
+var chinaTime = time.FixedZone("CST", 8*60*60)
+
+// SolarTermTime returns the time the sun reaches the longitude of the solar term in the year.
+// It is computed with the low accuracy solar coordinates of Meeus' Astronomical Algorithms
+// and is within about 15 minutes of the published times.
+func SolarTermTime(year int, term SolarTerm) time.Time {
+	// start from the mean sun and refine with Newton's method
+	target := term.Longitude()
+	jd := ToJulianDay(time.Date(year, time.January, 6, 0, 0, 0, 0, time.UTC)) + float64(term)*365.2422/24
+	for i := 0; i < 10; i++ {
+		delta := math.Mod(target-solarLongitude(jd)+540, 360) - 180
+		jd += delta * 365.2422 / 360
+		if math.Abs(delta) < 1e-7 {
+			break
+		}
+	}
+	return FromJulianDay(jd)
+}
+
+// SolarTermDate returns the date of the solar term in the year in China Standard Time
+func SolarTermDate(year int, term SolarTerm) time.Time {
+	return Day(SolarTermTime(year, term).In(chinaTime))
+}
+
+// solarLongitude returns the apparent longitude of the sun in degrees at the julian day
+func solarLongitude(jd float64) float64 {
+	const rad = math.Pi / 180
+	t := (jd - 2451545) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := (357.52911 + 35999.05029*t - 0.0001537*t*t) * rad
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+	omega := (125.04 - 1934.136*t) * rad
+	lon := l0 + c - 0.00569 - 0.00478*math.Sin(omega)
+	return math.Mod(math.Mod(lon, 360)+360, 360)
+}
+
+// chineseHoliday returns a holiday on the lunar month and day in the Gregorian year of date
+func chineseHoliday(month, day int) HolidayFunc {
+	return func(date time.Time) time.Time {
+		t, err := FromChinese(ChineseDate{Year: date.Year(), Month: month, Day: day})
+		if err != nil {
+			return time.Time{}
+		}
+		return t
+	}
+}
+
+// LunarNewYear is the Chinese (Spring Festival) new year on the 1st day of the 1st lunar month
+func LunarNewYear(date time.Time) time.Time {
+	return chineseHoliday(1, 1)(date)
+}
+
+// DragonBoatFestival is the 5th day of the 5th lunar month
+func DragonBoatFestival(date time.Time) time.Time {
+	return chineseHoliday(5, 5)(date)
+}
+
+// MidAutumnFestival is the 15th day of the 8th lunar month
+func MidAutumnFestival(date time.Time) time.Time {
+	return chineseHoliday(8, 15)(date)
+}
+
+// Qingming is the Qingming (Tomb Sweeping) festival on the day of the solar term QingMing
+func Qingming(date time.Time) time.Time {
+	return SolarTermDate(date.Year(), QingMing)
+}
@@ -0,0 +1,117 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestToChinese(t *testing.T) {
+	fn := func(in time.Time) (ChineseDate, error) {
+		return ToChinese(in)
+	}
+
+	cases := trial.Cases[time.Time, ChineseDate]{
+		"epoch":          {Input: Date(1900, 1, 31), Expected: ChineseDate{Year: 1900, Month: 1, Day: 1}},
+		"new year":       {Input: Date(2024, 2, 10), Expected: ChineseDate{Year: 2024, Month: 1, Day: 1}},
+		"new year's eve": {Input: Date(2024, 2, 9), Expected: ChineseDate{Year: 2023, Month: 12, Day: 30}},
+		"leap month":     {Input: Date(2023, 3, 22), Expected: ChineseDate{Year: 2023, Month: 2, Day: 1, Leap: true}},
+		"after leap":     {Input: Date(2023, 4, 20), Expected: ChineseDate{Year: 2023, Month: 3, Day: 1}},
+		"mid-autumn":     {Input: Date(2024, 9, 17), Expected: ChineseDate{Year: 2024, Month: 8, Day: 15}},
+		"before table":   {Input: Date(1900, 1, 30), ExpectedErr: ErrLunarRange},
+		"after table":    {Input: Date(2101, 6, 1), ExpectedErr: ErrLunarRange},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFromChinese(t *testing.T) {
+	fn := func(in ChineseDate) (time.Time, error) {
+		return FromChinese(in)
+	}
+
+	cases := trial.Cases[ChineseDate, time.Time]{
+		"new year":       {Input: ChineseDate{Year: 2025, Month: 1, Day: 1}, Expected: Date(2025, 1, 29)},
+		"leap month":     {Input: ChineseDate{Year: 2023, Month: 2, Day: 1, Leap: true}, Expected: Date(2023, 3, 22)},
+		"leap month 6":   {Input: ChineseDate{Year: 2025, Month: 6, Day: 1, Leap: true}, Expected: Date(2025, 7, 25)},
+		"not leap month": {Input: ChineseDate{Year: 2024, Month: 2, Day: 1, Leap: true}, ShouldErr: true},
+		"day 30":         {Input: ChineseDate{Year: 2024, Month: 12, Day: 30}, ShouldErr: true},
+		"out of range":   {Input: ChineseDate{Year: 1899, Month: 1, Day: 1}, ExpectedErr: ErrLunarRange},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	for d := Date(1900, 1, 31); d.Year() < 2100; d = d.AddDate(0, 0, 1) {
+		c, err := ToChinese(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := FromChinese(c); err != nil || !got.Equal(d) {
+			t.Fatalf("round trip %v: got %v %v", d, got, err)
+		}
+	}
+}
+
+func TestLunarNewYear(t *testing.T) {
+	expected := map[int]time.Time{
+		1901: Date(1901, 2, 19), 1930: Date(1930, 1, 30), 1950: Date(1950, 2, 17),
+		1970: Date(1970, 2, 6), 1990: Date(1990, 1, 27), 2000: Date(2000, 2, 5),
+		2020: Date(2020, 1, 25), 2023: Date(2023, 1, 22), 2026: Date(2026, 2, 17),
+		2030: Date(2030, 2, 3), 2050: Date(2050, 1, 23),
+	}
+	for year, want := range expected {
+		if got := LunarNewYear(Date(year, 6, 1)); !got.Equal(want) {
+			t.Errorf("%d: got %v, want %v", year, got, want)
+		}
+	}
+}
+
+func TestChineseHolidays(t *testing.T) {
+	fn := func(in HolidayFunc) ([]time.Time, error) {
+		return []time.Time{in(Date(2023, 1, 1)), in(Date(2024, 1, 1)), in(Date(2025, 1, 1))}, nil
+	}
+
+	cases := trial.Cases[HolidayFunc, []time.Time]{
+		"Lunar New Year": {Input: LunarNewYear, Expected: []time.Time{Date(2023, 1, 22), Date(2024, 2, 10), Date(2025, 1, 29)}},
+		"Qingming":       {Input: Qingming, Expected: []time.Time{Date(2023, 4, 5), Date(2024, 4, 4), Date(2025, 4, 4)}},
+		"Dragon Boat":    {Input: DragonBoatFestival, Expected: []time.Time{Date(2023, 6, 22), Date(2024, 6, 10), Date(2025, 5, 31)}},
+		"Mid-Autumn":     {Input: MidAutumnFestival, Expected: []time.Time{Date(2023, 9, 29), Date(2024, 9, 17), Date(2025, 10, 6)}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if got := LunarNewYear(Date(2101, 1, 1)); !got.IsZero() {
+		t.Errorf("expected no holiday after the table, got %v", got)
+	}
+}
+
+func TestSolarTermDate(t *testing.T) {
+	type input struct {
+		year int
+		term SolarTerm
+	}
+	fn := func(in input) (time.Time, error) {
+		return SolarTermDate(in.year, in.term), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"minor cold":      {Input: input{2024, XiaoHan}, Expected: Date(2024, 1, 6)},
+		"start of spring": {Input: input{2025, LiChun}, Expected: Date(2025, 2, 3)},
+		"spring equinox":  {Input: input{2024, ChunFen}, Expected: Date(2024, 3, 20)},
+		"summer solstice": {Input: input{2023, XiaZhi}, Expected: Date(2023, 6, 21)},
+		"autumn equinox":  {Input: input{2025, QiuFen}, Expected: Date(2025, 9, 23)},
+		"winter solstice": {Input: input{2024, DongZhi}, Expected: Date(2024, 12, 21)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	// 2024 winter solstice at 09:21 UTC
+	got := SolarTermTime(2024, DongZhi)
+	if want := time.Date(2024, 12, 21, 9, 21, 0, 0, time.UTC); got.Sub(want).Abs() > 15*time.Minute {
+		t.Errorf("got %v, want about %v", got, want)
+	}
+	if QingMing.String() != "Pure Brightness" || QingMing.Longitude() != 15 || XiaoHan.Longitude() != 285 {
+		t.Errorf("got %s %v", QingMing, QingMing.Longitude())
+	}
+}
@@ -0,0 +1,248 @@
+// godates computes reporting periods, holidays and business days from the command line.
+//
+//	godates range lfw --asof 2024-02-05 --week mon-sun
+//	godates range "previous quarter" --format json
+//	godates holidays 2025 --calendar us
+//	godates bizdays 2024-01-01 2024-03-31 --format csv
+//	godates serve --addr :8080
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	dates "github.com/hydronica/godates"
+	"github.com/hydronica/godates/httpapi"
+)
+
+const usage = `usage: godates <command> [arguments] [flags]
+
+commands:
+  range <period>          start and end dates of a period i.e., lfw, mtd, pymtd, "last full week"
+  holidays <year>         holidays of a calendar for the year
+  bizdays <start> <end>   number of business days from start to end (inclusive)
+  serve                   serve the calculations as JSON over HTTP
+
+flags:
+  --asof date       date periods are relative to (default today)
+  --week start-end  week definition for week periods (default mon-sun)
+  --calendar name   holiday calendar or a .yaml/.json calendar file (default us)
+  --format format   output format: text, json or csv (default text)
+  --addr address    address to serve HTTP on (default :8080)
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprint(os.Stderr, usage)
+		}
+		os.Exit(1)
+	}
+}
+
+var errUsage = errors.New("invalid usage")
+
+type options struct {
+	asof     string
+	week     string
+	calendar string
+	format   string
+	addr     string
+}
+
+// run executes the command in args and writes the result to w
+func run(args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing command", errUsage)
+	}
+	cmd := args[0]
+
+	opts := options{
+		asof:     time.Now().Format(time.DateOnly),
+		week:     "mon-sun",
+		calendar: "us",
+		format:   "text",
+		addr:     ":8080",
+	}
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&opts.asof, "asof", opts.asof, "")
+	fs.StringVar(&opts.week, "week", opts.week, "")
+	fs.StringVar(&opts.calendar, "calendar", opts.calendar, "")
+	fs.StringVar(&opts.format, "format", opts.format, "")
+	fs.StringVar(&opts.addr, "addr", opts.addr, "")
+
+	// flags may come before or after the positional arguments
+	var pos []string
+	rest := args[1:]
+	for {
+		if err := fs.Parse(rest); err != nil {
+			return fmt.Errorf("%w: %v", errUsage, err)
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		pos = append(pos, fs.Arg(0))
+		rest = fs.Args()[1:]
+	}
+
+	switch opts.format {
+	case "text", "json", "csv":
+	default:
+		return fmt.Errorf("%w: unknown format %q", errUsage, opts.format)
+	}
+
+	switch cmd {
+	case "range":
+		return runRange(pos, opts, w)
+	case "holidays":
+		return runHolidays(pos, opts, w)
+	case "bizdays":
+		return runBizdays(pos, opts, w)
+	case "serve":
+		slog.Info("serving dates", "addr", opts.addr)
+		return http.ListenAndServe(opts.addr, httpapi.NewHandler())
+	case "help", "-h", "--help":
+		_, err := fmt.Fprint(w, usage)
+		return err
+	}
+	return fmt.Errorf("%w: unknown command %q", errUsage, cmd)
+}
+
+func runRange(args []string, opts options, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: range needs a period", errUsage)
+	}
+	period := strings.Join(args, " ")
+	asof, err := time.Parse(time.DateOnly, opts.asof)
+	if err != nil {
+		return fmt.Errorf("invalid asof date: %w", err)
+	}
+	week, err := dates.ParseWeek(opts.week)
+	if err != nil {
+		return err
+	}
+	r, err := week.ParsePeriod(period, asof)
+	if err != nil {
+		return err
+	}
+
+	start, end := r.Start.Format(time.DateOnly), r.End.Format(time.DateOnly)
+	switch opts.format {
+	case "json":
+		return writeJSON(w, map[string]string{"period": period, "start": start, "end": end})
+	case "csv":
+		return writeCSV(w, []string{"period", "start", "end"}, [][]string{{period, start, end}})
+	}
+	_, err = fmt.Fprintf(w, "%s\t%s\n", start, end)
+	return err
+}
+
+func runHolidays(args []string, opts options, w io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: holidays needs a year", errUsage)
+	}
+	year, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid year %q", args[0])
+	}
+	cal, err := lookupCalendar(opts.calendar)
+	if err != nil {
+		return err
+	}
+
+	holidays := cal.HolidaysIn(year)
+	switch opts.format {
+	case "json":
+		type holiday struct {
+			Date string `json:"date"`
+			Name string `json:"name"`
+		}
+		list := make([]holiday, 0, len(holidays))
+		for _, h := range holidays {
+			list = append(list, holiday{Date: h.Date.Format(time.DateOnly), Name: h.Name})
+		}
+		return writeJSON(w, list)
+	case "csv":
+		rows := make([][]string, 0, len(holidays))
+		for _, h := range holidays {
+			rows = append(rows, []string{h.Date.Format(time.DateOnly), h.Name})
+		}
+		return writeCSV(w, []string{"date", "name"}, rows)
+	}
+	for _, h := range holidays {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", h.Date.Format(time.DateOnly), h.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runBizdays(args []string, opts options, w io.Writer) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: bizdays needs a start and end date", errUsage)
+	}
+	start, err := time.Parse(time.DateOnly, args[0])
+	if err != nil {
+		return fmt.Errorf("invalid start date: %w", err)
+	}
+	end, err := time.Parse(time.DateOnly, args[1])
+	if err != nil {
+		return fmt.Errorf("invalid end date: %w", err)
+	}
+	cal, err := lookupCalendar(opts.calendar)
+	if err != nil {
+		return err
+	}
+
+	days := cal.BusinessDays(dates.NewRange(start, end))
+	switch opts.format {
+	case "json":
+		return writeJSON(w, map[string]any{"start": args[0], "end": args[1], "business_days": days})
+	case "csv":
+		return writeCSV(w, []string{"start", "end", "business_days"}, [][]string{{args[0], args[1], strconv.Itoa(days)}})
+	}
+	_, err = fmt.Fprintln(w, days)
+	return err
+}
+
+func lookupCalendar(name string) (dates.Calendar, error) {
+	switch filepath.Ext(name) {
+	case ".json", ".yaml", ".yml":
+		return dates.LoadCalendar(name)
+	}
+	cal, ok := dates.LookupCalendar(name)
+	if !ok {
+		return cal, fmt.Errorf("unknown calendar %q, expected one of %s", name, strings.Join(dates.CalendarNames(), ", "))
+	}
+	return cal, nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func writeCSV(w io.Writer, header []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
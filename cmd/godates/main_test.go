@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hydronica/trial"
+)
+
+func TestRun(t *testing.T) {
+	fn := func(in []string) (string, error) {
+		var b strings.Builder
+		err := run(in, &b)
+		return b.String(), err
+	}
+
+	cases := trial.Cases[[]string, string]{
+		"range": {
+			Input:    []string{"range", "lfw", "--asof", "2024-02-05", "--week", "mon-sun"},
+			Expected: "2024-01-29\t2024-02-04\n",
+		},
+		"range phrase with flags first": {
+			Input:    []string{"range", "--asof=2024-02-05", "--week=sun-sat", "last", "full", "week"},
+			Expected: "2024-01-28\t2024-02-03\n",
+		},
+		"range json": {
+			Input:    []string{"range", "pymtd", "--asof", "2024-02-15", "--format", "json"},
+			Expected: "{\n  \"end\": \"2023-02-15\",\n  \"period\": \"pymtd\",\n  \"start\": \"2023-02-01\"\n}\n",
+		},
+		"holidays csv": {
+			Input:    []string{"holidays", "2025", "--calendar", "us", "--format", "csv"},
+			Expected: "date,name\n2025-01-01,New Year's Day\n2025-01-20,Martin Luther King Jr. Day\n2025-02-17,Washington's Birthday\n2025-05-26,Memorial Day\n2025-06-19,Juneteenth\n2025-07-04,Independence Day\n2025-09-01,Labor Day\n2025-10-13,Columbus Day\n2025-11-11,Veterans Day\n2025-11-27,Thanksgiving Day\n2025-12-25,Christmas Day\n",
+		},
+		"holidays from file": {
+			Input:    []string{"holidays", "2025", "--calendar", "../../testdata/acme.yaml", "--format", "csv"},
+			Expected: "date,name\n2025-01-01,New Year's Day\n2025-01-20,Martin Luther King Jr. Day\n2025-02-17,Washington's Birthday\n2025-05-26,Memorial Day\n2025-06-19,Juneteenth\n2025-07-04,Independence Day\n2025-09-01,Labor Day\n2025-10-13,Columbus Day\n2025-11-11,Veterans Day\n2025-11-27,Thanksgiving Day\n2025-11-28,Day after Thanksgiving\n2025-12-25,Christmas Day\n",
+		},
+		"bizdays": {
+			Input:    []string{"bizdays", "2024-01-01", "2024-03-31"},
+			Expected: "62\n",
+		},
+		"unknown period": {
+			Input:     []string{"range", "fortnight"},
+			ShouldErr: true,
+		},
+		"unknown calendar": {
+			Input:     []string{"holidays", "2025", "--calendar", "mars"},
+			ShouldErr: true,
+		},
+		"unknown command": {
+			Input:     []string{"weeks"},
+			ShouldErr: true,
+		},
+		"unknown format": {
+			Input:     []string{"bizdays", "2024-01-01", "2024-03-31", "--format", "xml"},
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
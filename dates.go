@@ -1,7 +1,9 @@
 package dates
 
 import (
+	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 )
 
@@ -50,7 +52,7 @@ func NewWeek(day ...time.Weekday) Week {
 		return w
 	}
 
-	if day1 == time.Sunday && day1 != time.Saturday {
+	if day1 == time.Sunday && day2 != time.Saturday {
 		slog.Warn("there are not 7 days in given week, using default")
 		return w
 	}
@@ -66,6 +68,26 @@ func NewWeek(day ...time.Weekday) Week {
 	return w
 }
 
+// ParseWeek returns the Week for text like "MON-SUN" or "sunday-saturday",
+// an error is returned if the days are unknown or not a 7 day week
+func ParseWeek(s string) (Week, error) {
+	first, last, ok := strings.Cut(strings.ToLower(strings.TrimSpace(s)), "-")
+	start, okStart := weekdays[strings.TrimSpace(first)]
+	end, okEnd := weekdays[strings.TrimSpace(last)]
+	if !ok || !okStart || !okEnd {
+		return Week{}, fmt.Errorf("invalid week %q: expected start and end weekdays i.e., MON-SUN", s)
+	}
+	if (start+6)%7 != end {
+		return Week{}, fmt.Errorf("invalid week %q: start and end days are not consecutive", s)
+	}
+	return Week{weekStart: start, weekEnd: end}, nil
+}
+
+// String returns the week as the start and end weekdays i.e., "MON-SUN"
+func (d Week) String() string {
+	return strings.ToUpper(English.WeekdaysAbbr[d.weekStart] + "-" + English.WeekdaysAbbr[d.weekEnd])
+}
+
 // Date provides a shorthand for createing a time.Date truncating the time.
 // timezone is ignored and UTC is used
 func Date(year int, month time.Month, day int) time.Time {
@@ -88,6 +110,24 @@ func WeekAdd(t time.Time, weeks int) time.Time {
 	return t
 }
 
+// MonthAdd returns t time with months added (use negative value to subtract).
+// The day is clamped to the last day of the resulting month,
+// for example Jan 31 plus one month is Feb 28 (or Feb 29 in a leap year)
+func MonthAdd(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := LastDayOfMonth(first).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+// YearAdd returns t time with years added (use negative value to subtract).
+// A leap day is clamped to Feb 28th in a non leap year
+func YearAdd(t time.Time, years int) time.Time {
+	return MonthAdd(t, years*12)
+}
+
 // PriorLastFullWeek returns the start and end dates of the week prior to the last full week
 // or two weeks ago
 func (d Week) PriorLastFullWeek(t time.Time) (start, end time.Time) {
@@ -224,3 +264,44 @@ func PrevYearToDate(t time.Time) (start, end time.Time) {
 func StartOfMonth(t time.Time) time.Time {
 	return Date(t.Year(), t.Month(), 1)
 }
+
+// Quarter returns the quarter (1-4) of the year for the given time t
+func Quarter(t time.Time) int {
+	return (int(t.Month())-1)/3 + 1
+}
+
+// StartOfQuarter returns the 1st day of the quarter for the given time t
+func StartOfQuarter(t time.Time) time.Time {
+	return Date(t.Year(), time.Month((Quarter(t)-1)*3+1), 1)
+}
+
+// FullQuarter returns the start and end dates of the current quarter
+func FullQuarter(t time.Time) (start, end time.Time) {
+	start = StartOfQuarter(t)
+	end = Date(start.Year(), start.Month()+3, 0)
+	return start, end
+}
+
+// QuarterToDate returns the start of the quarter up to the given date t
+func QuarterToDate(t time.Time) (start, end time.Time) {
+	start = StartOfQuarter(t)
+	end = t
+	return start, end
+}
+
+// PrevQuarter returns the start and end dates of the previous quarter
+func PrevQuarter(t time.Time) (start, end time.Time) {
+	return FullQuarter(StartOfQuarter(t).Add(-OneDay))
+}
+
+// FullYear returns the start and end dates of the current year
+func FullYear(t time.Time) (start, end time.Time) {
+	start = Date(t.Year(), 1, 1)
+	end = Date(t.Year(), 12, 31)
+	return start, end
+}
+
+// PrevYear returns the start and end dates of the previous year
+func PrevYear(t time.Time) (start, end time.Time) {
+	return FullYear(Date(t.Year()-1, 1, 1))
+}
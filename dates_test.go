@@ -505,3 +505,153 @@ func TestLastDayOfMonth(t *testing.T) {
 	}
 	trial.New(fn, cases).SubTest(t)
 }
+
+func TestMonthAdd(t *testing.T) {
+	type input struct {
+		date   time.Time
+		months int
+	}
+	fn := func(in input) (time.Time, error) {
+		return MonthAdd(in.date, in.months), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"one month forward": {
+			Input:    input{Date(2024, 6, 26), 1},
+			Expected: Date(2024, 7, 26),
+		},
+		"end of month clamped": {
+			Input:    input{Date(2024, 1, 31), 1},
+			Expected: Date(2024, 2, 29),
+		},
+		"end of month non leap year": {
+			Input:    input{Date(2023, 1, 31), 1},
+			Expected: Date(2023, 2, 28),
+		},
+		"previous year": {
+			Input:    input{Date(2024, 3, 31), -4},
+			Expected: Date(2023, 11, 30),
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestYearAdd(t *testing.T) {
+	type input struct {
+		date  time.Time
+		years int
+	}
+	fn := func(in input) (time.Time, error) {
+		return YearAdd(in.date, in.years), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"normal date": {
+			Input:    input{Date(2024, 6, 26), 1},
+			Expected: Date(2025, 6, 26),
+		},
+		"leap day": {
+			Input:    input{Date(2024, 2, 29), -1},
+			Expected: Date(2023, 2, 28),
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFullQuarter(t *testing.T) {
+	fn := func(in time.Time) (output, error) {
+		start, end := FullQuarter(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"first quarter": {
+			Input:    Date(2024, 2, 15),
+			Expected: output{start: Date(2024, 1, 1), end: Date(2024, 3, 31)},
+		},
+		"last quarter": {
+			Input:    Date(2024, 12, 31),
+			Expected: output{start: Date(2024, 10, 1), end: Date(2024, 12, 31)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPrevQuarter(t *testing.T) {
+	fn := func(in time.Time) (output, error) {
+		start, end := PrevQuarter(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"normal date": {
+			Input:    Date(2024, 5, 15),
+			Expected: output{start: Date(2024, 1, 1), end: Date(2024, 3, 31)},
+		},
+		"previous year": {
+			Input:    Date(2024, 2, 29),
+			Expected: output{start: Date(2023, 10, 1), end: Date(2023, 12, 31)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPrevYear(t *testing.T) {
+	fn := func(in time.Time) (output, error) {
+		start, end := PrevYear(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"normal date": {
+			Input:    Date(2024, 5, 15),
+			Expected: output{start: Date(2023, 1, 1), end: Date(2023, 12, 31)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestParseWeek(t *testing.T) {
+	fn := func(in string) (Week, error) {
+		return ParseWeek(in)
+	}
+
+	cases := trial.Cases[string, Week]{
+		"abbreviated": {
+			Input:    "MON-SUN",
+			Expected: Week{weekStart: time.Monday, weekEnd: time.Sunday},
+		},
+		"full names": {
+			Input:    "sunday-saturday",
+			Expected: Week{weekStart: time.Sunday, weekEnd: time.Saturday},
+		},
+		"not consecutive": {
+			Input:     "mon-fri",
+			ShouldErr: true,
+		},
+		"unknown day": {
+			Input:     "mon-funday",
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWeekString(t *testing.T) {
+	fn := func(in Week) (string, error) {
+		return in.String(), nil
+	}
+
+	cases := trial.Cases[Week, string]{
+		"monday":   {Input: NewWeek(time.Monday, time.Sunday), Expected: "MON-SUN"},
+		"thursday": {Input: NewWeek(time.Thursday, time.Wednesday), Expected: "THU-WED"},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
@@ -0,0 +1,99 @@
+package dates
+
+import "time"
+
+// DayCount is a day count convention used to calculate interest as a fraction of a year
+type DayCount int
+
+const (
+	Thirty360US       DayCount = iota // 30/360 US (bond basis) with the end of February rules
+	Thirty360European                 // 30E/360 (Eurobond basis)
+	Act360                            // actual days / 360
+	Act365Fixed                       // actual days / 365
+	ActActISDA                        // actual days in each year / days in that year
+	ActActICMA                        // actual days / days in the coupon period, see YearFractionICMA
+)
+
+// Days returns the number of days from start to end counted by the convention,
+// the 30/360 conventions count every month as 30 days and the others count actual days
+func (c DayCount) Days(start, end time.Time) int {
+	switch c {
+	case Thirty360US, Thirty360European:
+		return thirty360(start, end, c == Thirty360US)
+	}
+	return DaysBetween(start, end)
+}
+
+// thirty360 returns the 30/360 days from start to end,
+// us applies the US end of February rules instead of the European rules
+func thirty360(start, end time.Time, us bool) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if us {
+		lastFeb1 := m1 == time.February && d1 == LastDayOfMonth(start).Day()
+		lastFeb2 := m2 == time.February && d2 == LastDayOfMonth(end).Day()
+		if lastFeb1 && lastFeb2 {
+			d2 = 30
+		}
+		if lastFeb1 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	} else {
+		d1, d2 = min(d1, 30), min(d2, 30)
+	}
+	return 360*(y2-y1) + 30*(int(m2)-int(m1)) + d2 - d1
+}
+
+// daysInYear returns 366 for a leap year and 365 otherwise
+func daysInYear(year int) int {
+	return DaysBetween(Date(year, time.January, 1), Date(year+1, time.January, 1))
+}
+
+// YearFraction returns the fraction of a year from start to end by the day count convention,
+// the value is negative when end is before start. ActActICMA uses annual coupon periods
+// starting on start, use YearFractionICMA for other coupon schedules.
+func YearFraction(start, end time.Time, c DayCount) float64 {
+	start, end = Day(start), Day(end)
+	if end.Before(start) {
+		return -YearFraction(end, start, c)
+	}
+
+	switch c {
+	case Thirty360US, Thirty360European:
+		return float64(c.Days(start, end)) / 360
+	case Act360:
+		return float64(DaysBetween(start, end)) / 360
+	case Act365Fixed:
+		return float64(DaysBetween(start, end)) / 365
+	case ActActISDA:
+		y1, y2 := start.Year(), end.Year()
+		if y1 == y2 {
+			return float64(DaysBetween(start, end)) / float64(daysInYear(y1))
+		}
+		first := float64(DaysBetween(start, Date(y1+1, time.January, 1))) / float64(daysInYear(y1))
+		last := float64(DaysBetween(Date(y2, time.January, 1), end)) / float64(daysInYear(y2))
+		return first + float64(y2-y1-1) + last
+	case ActActICMA:
+		years := YearsBetween(start, end)
+		refStart := YearAdd(start, years)
+		return float64(years) + YearFractionICMA(refStart, end, refStart, YearAdd(start, years+1), 1)
+	}
+	return 0
+}
+
+// YearFractionICMA returns the ACT/ACT ICMA fraction of a year from start to end
+// within the coupon period refStart to refEnd that has freq coupons per year,
+// i.e., 91 days of a 182 day semi-annual period is 0.25
+func YearFractionICMA(start, end, refStart, refEnd time.Time, freq int) float64 {
+	days := DaysBetween(refStart, refEnd)
+	if days == 0 || freq == 0 {
+		return 0
+	}
+	return float64(DaysBetween(start, end)) / float64(freq*days)
+}
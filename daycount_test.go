@@ -0,0 +1,115 @@
+package dates
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+type dayCount struct {
+	start, end time.Time
+	c          DayCount
+}
+
+func TestDayCountDays(t *testing.T) {
+	fn := func(in dayCount) (int, error) {
+		return in.c.Days(in.start, in.end), nil
+	}
+
+	cases := trial.Cases[dayCount, int]{
+		"30/360 US 31st": {
+			Input:    dayCount{Date(2024, 1, 31), Date(2024, 2, 29), Thirty360US},
+			Expected: 29,
+		},
+		"30/360 US end of Feb": {
+			Input:    dayCount{Date(2024, 2, 29), Date(2024, 3, 31), Thirty360US},
+			Expected: 30,
+		},
+		"30E/360 end of Feb": {
+			Input:    dayCount{Date(2024, 2, 29), Date(2024, 3, 31), Thirty360European},
+			Expected: 31,
+		},
+		"30/360 US both Feb": {
+			Input:    dayCount{Date(2023, 2, 28), Date(2024, 2, 29), Thirty360US},
+			Expected: 360,
+		},
+		"30E/360 both Feb": {
+			Input:    dayCount{Date(2023, 2, 28), Date(2024, 2, 29), Thirty360European},
+			Expected: 361,
+		},
+		"30/360 US 31st to 31st": {
+			Input:    dayCount{Date(2024, 3, 31), Date(2024, 5, 31), Thirty360US},
+			Expected: 60,
+		},
+		"30/360 US 15th to 31st": {
+			Input:    dayCount{Date(2024, 3, 15), Date(2024, 5, 31), Thirty360US},
+			Expected: 76,
+		},
+		"actual": {
+			Input:    dayCount{Date(2024, 1, 1), Date(2024, 7, 1), Act360},
+			Expected: 182,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestYearFraction(t *testing.T) {
+	fn := func(in dayCount) (float64, error) {
+		return math.Round(YearFraction(in.start, in.end, in.c)*1e6) / 1e6, nil
+	}
+
+	cases := trial.Cases[dayCount, float64]{
+		"30/360 US": {
+			Input:    dayCount{Date(2023, 2, 28), Date(2024, 2, 29), Thirty360US},
+			Expected: 1,
+		},
+		"30E/360": {
+			Input:    dayCount{Date(2024, 1, 15), Date(2024, 7, 15), Thirty360European},
+			Expected: 0.5,
+		},
+		"ACT/360": {
+			Input:    dayCount{Date(2024, 1, 1), Date(2024, 7, 1), Act360},
+			Expected: 0.505556,
+		},
+		"ACT/365F": {
+			Input:    dayCount{Date(2024, 1, 1), Date(2024, 7, 1), Act365Fixed},
+			Expected: 0.49863,
+		},
+		"ACT/ACT ISDA": {
+			Input:    dayCount{Date(2023, 7, 1), Date(2024, 7, 1), ActActISDA},
+			Expected: 1.001377,
+		},
+		"ACT/ACT ISDA same year": {
+			Input:    dayCount{Date(2024, 1, 1), Date(2024, 7, 1), ActActISDA},
+			Expected: 0.497268,
+		},
+		"ACT/ACT ISDA several years": {
+			Input:    dayCount{Date(2022, 12, 31), Date(2025, 1, 1), ActActISDA},
+			Expected: 2.00274,
+		},
+		"ACT/ACT ICMA": {
+			Input:    dayCount{Date(2024, 1, 15), Date(2024, 7, 15), ActActICMA},
+			Expected: 0.497268,
+		},
+		"ACT/ACT ICMA years": {
+			Input:    dayCount{Date(2023, 1, 15), Date(2025, 1, 15), ActActICMA},
+			Expected: 2,
+		},
+		"negative": {
+			Input:    dayCount{Date(2024, 7, 1), Date(2024, 1, 1), Act360},
+			Expected: -0.505556,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestYearFractionICMA(t *testing.T) {
+	got := YearFractionICMA(Date(2024, 1, 15), Date(2024, 4, 15), Date(2024, 1, 15), Date(2024, 7, 15), 2)
+	if got != 0.25 {
+		t.Errorf("got %v, want 0.25", got)
+	}
+}
@@ -0,0 +1,105 @@
+package dates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Difference is the calendar breakdown between two dates
+// for example 2 years, 3 months and 5 days
+type Difference struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// String returns the difference as "2 years, 3 months, 5 days",
+// components that are zero are left out
+func (d Difference) String() string {
+	parts := make([]string, 0, 3)
+	add := func(n int, unit string) {
+		if n == 0 {
+			return
+		}
+		if n != 1 && n != -1 {
+			unit += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, unit))
+	}
+	add(d.Years, "year")
+	add(d.Months, "month")
+	add(d.Days, "day")
+	if len(parts) == 0 {
+		return "0 days"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Diff returns the years, months and days from a to b, the time of day is ignored.
+// Months are counted by anniversary, when the anniversary day does not exist in a month
+// it falls on the last day of that month (see MonthAdd), so Jan 31 to Feb 29 is 1 month
+// and a leap day to Feb 28th of the next year is 1 year.
+// If b is before a all components are negative.
+func Diff(a, b time.Time) Difference {
+	a, b = Day(a), Day(b)
+	if b.Before(a) {
+		d := Diff(b, a)
+		return Difference{Years: -d.Years, Months: -d.Months, Days: -d.Days}
+	}
+
+	months := wholeMonths(a, b)
+	anniversary := MonthAdd(a, months)
+	return Difference{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   DaysBetween(anniversary, b),
+	}
+}
+
+// wholeMonths returns the number of monthly anniversaries of a up to b (a <= b)
+func wholeMonths(a, b time.Time) int {
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	if MonthAdd(a, months).After(b) {
+		months--
+	}
+	return months
+}
+
+// DaysBetween returns the number of days from a to b, the time of day is ignored.
+// The value is negative when b is before a
+func DaysBetween(a, b time.Time) int {
+	return ToEpochDay(b) - ToEpochDay(a)
+}
+
+// MonthsBetween returns the number of whole months from a to b using the same
+// end of month rules as Diff. The value is negative when b is before a
+func MonthsBetween(a, b time.Time) int {
+	d := Diff(a, b)
+	return d.Years*12 + d.Months
+}
+
+// YearsBetween returns the number of whole years from a to b, i.e., the age
+// on date b of something that started on a. The value is negative when b is before a
+func YearsBetween(a, b time.Time) int {
+	return Diff(a, b).Years
+}
+
+// WeeksBetween returns the number of week boundaries (start of week days)
+// crossed going from a to b. Two dates in the same week are 0 weeks apart.
+// The value is negative when b is before a
+func (d Week) WeeksBetween(a, b time.Time) int {
+	return DaysBetween(d.StartOfWeek(a), d.StartOfWeek(b)) / 7
+}
+
+// CalendarMonthsBetween returns the number of month boundaries crossed
+// going from a to b, for example Jan 31 to Feb 1 is 1 calendar month
+func CalendarMonthsBetween(a, b time.Time) int {
+	return (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+}
+
+// CalendarYearsBetween returns the number of year boundaries crossed
+// going from a to b, for example Dec 31 to Jan 1 is 1 calendar year
+func CalendarYearsBetween(a, b time.Time) int {
+	return b.Year() - a.Year()
+}
@@ -0,0 +1,212 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+type pair struct {
+	a time.Time
+	b time.Time
+}
+
+func TestDiff(t *testing.T) {
+	fn := func(in pair) (Difference, error) {
+		return Diff(in.a, in.b), nil
+	}
+
+	cases := trial.Cases[pair, Difference]{
+		"same day": {
+			Input:    pair{Date(2024, 2, 5), Date(2024, 2, 5)},
+			Expected: Difference{},
+		},
+		"years months and days": {
+			Input:    pair{Date(2021, 11, 10), Date(2024, 2, 15)},
+			Expected: Difference{Years: 2, Months: 3, Days: 5},
+		},
+		"end of month": {
+			Input:    pair{Date(2024, 1, 31), Date(2024, 2, 29)},
+			Expected: Difference{Months: 1},
+		},
+		"end of month plus a day": {
+			Input:    pair{Date(2024, 1, 31), Date(2024, 3, 1)},
+			Expected: Difference{Months: 1, Days: 1},
+		},
+		"leap day anniversary": {
+			Input:    pair{Date(2024, 2, 29), Date(2025, 2, 28)},
+			Expected: Difference{Years: 1},
+		},
+		"leap day before anniversary": {
+			Input:    pair{Date(2024, 2, 29), Date(2025, 2, 27)},
+			Expected: Difference{Months: 11, Days: 29},
+		},
+		"time of day ignored": {
+			Input:    pair{time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC)},
+			Expected: Difference{Days: 1},
+		},
+		"reversed": {
+			Input:    pair{Date(2024, 2, 15), Date(2021, 11, 10)},
+			Expected: Difference{Years: -2, Months: -3, Days: -5},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestDifferenceString(t *testing.T) {
+	fn := func(in Difference) (string, error) {
+		return in.String(), nil
+	}
+
+	cases := trial.Cases[Difference, string]{
+		"all parts": {
+			Input:    Difference{Years: 2, Months: 3, Days: 5},
+			Expected: "2 years, 3 months, 5 days",
+		},
+		"singular": {
+			Input:    Difference{Years: 1, Days: 1},
+			Expected: "1 year, 1 day",
+		},
+		"zero": {
+			Input:    Difference{},
+			Expected: "0 days",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestDaysBetween(t *testing.T) {
+	fn := func(in pair) (int, error) {
+		return DaysBetween(in.a, in.b), nil
+	}
+
+	cases := trial.Cases[pair, int]{
+		"same day": {
+			Input:    pair{time.Date(2024, 2, 5, 23, 0, 0, 0, time.UTC), Date(2024, 2, 5)},
+			Expected: 0,
+		},
+		"leap year": {
+			Input:    pair{Date(2024, 1, 1), Date(2025, 1, 1)},
+			Expected: 366,
+		},
+		"backwards": {
+			Input:    pair{Date(2024, 3, 1), Date(2024, 2, 1)},
+			Expected: -29,
+		},
+		"over 300 years": {
+			Input:    pair{Date(1700, 1, 1), Date(2024, 1, 1)},
+			Expected: 118338,
+		},
+		"over 300 years backwards": {
+			Input:    pair{Date(2024, 1, 1), Date(1700, 1, 1)},
+			Expected: -118338,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestMonthsBetween(t *testing.T) {
+	fn := func(in pair) (int, error) {
+		return MonthsBetween(in.a, in.b), nil
+	}
+
+	cases := trial.Cases[pair, int]{
+		"whole months": {
+			Input:    pair{Date(2023, 1, 15), Date(2024, 3, 15)},
+			Expected: 14,
+		},
+		"partial month": {
+			Input:    pair{Date(2024, 1, 15), Date(2024, 2, 14)},
+			Expected: 0,
+		},
+		"end of month": {
+			Input:    pair{Date(2023, 8, 31), Date(2023, 9, 30)},
+			Expected: 1,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestYearsBetween(t *testing.T) {
+	fn := func(in pair) (int, error) {
+		return YearsBetween(in.a, in.b), nil
+	}
+
+	cases := trial.Cases[pair, int]{
+		"birthday": {
+			Input:    pair{Date(1990, 6, 15), Date(2024, 6, 15)},
+			Expected: 34,
+		},
+		"day before birthday": {
+			Input:    pair{Date(1990, 6, 15), Date(2024, 6, 14)},
+			Expected: 33,
+		},
+		"leap day birthday": {
+			Input:    pair{Date(2000, 2, 29), Date(2023, 2, 28)},
+			Expected: 23,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWeeksBetween(t *testing.T) {
+	d := NewWeek(time.Monday, time.Sunday)
+	s := NewWeek(time.Sunday, time.Saturday)
+	type input struct {
+		week Week
+		pair
+	}
+	fn := func(in input) (int, error) {
+		return in.week.WeeksBetween(in.a, in.b), nil
+	}
+
+	cases := trial.Cases[input, int]{
+		"same week": {
+			Input:    input{d, pair{Date(2024, 2, 5), Date(2024, 2, 11)}},
+			Expected: 0,
+		},
+		"crosses monday": {
+			Input:    input{d, pair{Date(2024, 2, 11), Date(2024, 2, 12)}},
+			Expected: 1,
+		},
+		"sunday start": {
+			Input:    input{s, pair{Date(2024, 2, 10), Date(2024, 2, 11)}},
+			Expected: 1,
+		},
+		"backwards": {
+			Input:    input{d, pair{Date(2024, 2, 19), Date(2024, 2, 5)}},
+			Expected: -2,
+		},
+		"centuries": {
+			Input:    input{d, pair{Date(1700, 1, 4), Date(2024, 1, 1)}},
+			Expected: 16905,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestCalendarMonthsBetween(t *testing.T) {
+	fn := func(in pair) (int, error) {
+		return CalendarMonthsBetween(in.a, in.b), nil
+	}
+
+	cases := trial.Cases[pair, int]{
+		"one day apart": {
+			Input:    pair{Date(2024, 1, 31), Date(2024, 2, 1)},
+			Expected: 1,
+		},
+		"across years": {
+			Input:    pair{Date(2023, 11, 1), Date(2024, 2, 29)},
+			Expected: 3,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
@@ -0,0 +1,116 @@
+// Package dimdate generates a date dimension table (dim_date) with one row per day
+// and writes it as CSV, JSON Lines or SQL INSERT statements.
+package dimdate
+
+import (
+	"time"
+
+	dates "github.com/hydronica/godates"
+)
+
+// Config is the years and calendar settings of a date dimension
+type Config struct {
+	StartYear int // first year of the table
+	EndYear   int // last year of the table (inclusive)
+
+	Week     dates.Week     // week used for the week start and week of year
+	Fiscal   dates.Fiscal   // fiscal year settings
+	Calendar dates.Calendar // holidays and weekend days
+}
+
+// Row is one day of a date dimension
+type Row struct {
+	DateKey    int // yyyymmdd i.e., 20240205
+	Date       time.Time
+	DayOfWeek  int // 0-6, Sunday is 0
+	DayName    string
+	DayOfMonth int
+	DayOfYear  int
+
+	WeekStart  time.Time
+	WeekOfYear int
+	ISOYear    int
+	ISOWeek    int
+
+	Month        int
+	MonthName    string
+	MonthStart   time.Time
+	Quarter      int
+	QuarterStart time.Time
+	Year         int
+	YearStart    time.Time
+
+	FiscalYear    int
+	FiscalQuarter int
+	FiscalPeriod  int
+
+	IsWeekend          bool
+	IsHoliday          bool
+	HolidayName        string
+	IsBusinessDay      bool
+	BusinessDayOfMonth int // ordinal of the business day in the month, 0 if not a business day
+}
+
+// Generate returns a row for every day from Jan 1st of the start year to Dec 31st of the end year
+func Generate(cfg Config) []Row {
+	first := dates.Date(cfg.StartYear, time.January, 1)
+	last := dates.Date(cfg.EndYear, time.December, 31)
+	if last.Before(first) {
+		return nil
+	}
+
+	holidays := make(map[time.Time]string)
+	for year := cfg.StartYear; year <= cfg.EndYear; year++ {
+		for _, h := range cfg.Calendar.HolidaysIn(year) {
+			if _, ok := holidays[h.Date]; !ok {
+				holidays[h.Date] = h.Name
+			}
+		}
+	}
+
+	rows := make([]Row, 0, dates.DaysBetween(first, last)+1)
+	bizDay := 0
+	for t := first; !t.After(last); t = t.AddDate(0, 0, 1) {
+		if t.Day() == 1 {
+			bizDay = 0
+		}
+		holiday, isHoliday := holidays[t]
+		weekend := cfg.Calendar.IsWeekend(t)
+		business := !weekend && !isHoliday
+		row := Row{
+			DateKey:    t.Year()*10000 + int(t.Month())*100 + t.Day(),
+			Date:       t,
+			DayOfWeek:  int(t.Weekday()),
+			DayName:    t.Weekday().String(),
+			DayOfMonth: t.Day(),
+			DayOfYear:  t.YearDay(),
+
+			WeekStart: cfg.Week.StartOfWeek(t),
+
+			Month:        int(t.Month()),
+			MonthName:    t.Month().String(),
+			MonthStart:   dates.StartOfMonth(t),
+			Quarter:      dates.Quarter(t),
+			QuarterStart: dates.StartOfQuarter(t),
+			Year:         t.Year(),
+			YearStart:    dates.Date(t.Year(), time.January, 1),
+
+			FiscalYear:    cfg.Fiscal.Year(t),
+			FiscalQuarter: cfg.Fiscal.Quarter(t),
+			FiscalPeriod:  cfg.Fiscal.Period(t),
+
+			IsWeekend:     weekend,
+			IsHoliday:     isHoliday,
+			HolidayName:   holiday,
+			IsBusinessDay: business,
+		}
+		_, row.WeekOfYear = cfg.Week.WeekNumber(t)
+		row.ISOYear, row.ISOWeek = t.ISOWeek()
+		if business {
+			bizDay++
+			row.BusinessDayOfMonth = bizDay
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,73 @@
+package dimdate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+
+	dates "github.com/hydronica/godates"
+)
+
+func TestGenerate(t *testing.T) {
+	rows := Generate(Config{
+		StartYear: 2023,
+		EndYear:   2024,
+		Week:      dates.NewWeek(time.Monday, time.Sunday),
+		Fiscal:    dates.NewFiscal(time.October),
+		Calendar:  dates.USFederal,
+	})
+	byDate := make(map[time.Time]Row, len(rows))
+	for _, r := range rows {
+		byDate[r.Date] = r
+	}
+
+	fn := func(in time.Time) (Row, error) {
+		return byDate[in], nil
+	}
+
+	cases := trial.Cases[time.Time, Row]{
+		"holiday": {
+			Input: dates.Date(2024, 1, 15),
+			Expected: Row{
+				DateKey: 20240115, Date: dates.Date(2024, 1, 15),
+				DayOfWeek: 1, DayName: "Monday", DayOfMonth: 15, DayOfYear: 15,
+				WeekStart: dates.Date(2024, 1, 15), WeekOfYear: 3, ISOYear: 2024, ISOWeek: 3,
+				Month: 1, MonthName: "January", MonthStart: dates.Date(2024, 1, 1),
+				Quarter: 1, QuarterStart: dates.Date(2024, 1, 1), Year: 2024, YearStart: dates.Date(2024, 1, 1),
+				FiscalYear: 2024, FiscalQuarter: 2, FiscalPeriod: 4,
+				IsHoliday: true, HolidayName: "Martin Luther King Jr. Day",
+			},
+		},
+		"business day": {
+			Input: dates.Date(2024, 1, 16),
+			Expected: Row{
+				DateKey: 20240116, Date: dates.Date(2024, 1, 16),
+				DayOfWeek: 2, DayName: "Tuesday", DayOfMonth: 16, DayOfYear: 16,
+				WeekStart: dates.Date(2024, 1, 15), WeekOfYear: 3, ISOYear: 2024, ISOWeek: 3,
+				Month: 1, MonthName: "January", MonthStart: dates.Date(2024, 1, 1),
+				Quarter: 1, QuarterStart: dates.Date(2024, 1, 1), Year: 2024, YearStart: dates.Date(2024, 1, 1),
+				FiscalYear: 2024, FiscalQuarter: 2, FiscalPeriod: 4,
+				IsBusinessDay: true, BusinessDayOfMonth: 10,
+			},
+		},
+		"weekend in the previous iso year": {
+			Input: dates.Date(2023, 1, 1),
+			Expected: Row{
+				DateKey: 20230101, Date: dates.Date(2023, 1, 1),
+				DayOfWeek: 0, DayName: "Sunday", DayOfMonth: 1, DayOfYear: 1,
+				WeekStart: dates.Date(2022, 12, 26), WeekOfYear: 52, ISOYear: 2022, ISOWeek: 52,
+				Month: 1, MonthName: "January", MonthStart: dates.Date(2023, 1, 1),
+				Quarter: 1, QuarterStart: dates.Date(2023, 1, 1), Year: 2023, YearStart: dates.Date(2023, 1, 1),
+				FiscalYear: 2023, FiscalQuarter: 2, FiscalPeriod: 4,
+				IsWeekend: true,
+			},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if len(rows) != 731 {
+		t.Errorf("expected 731 rows, got %d", len(rows))
+	}
+}
@@ -0,0 +1,161 @@
+package dimdate
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// column is a column of the table and how to get its value from a row
+type column struct {
+	name  string
+	value func(r Row) any // int, string, bool or time.Time
+}
+
+// columns of the date dimension in the order they are written
+var columns = []column{
+	{"date_key", func(r Row) any { return r.DateKey }},
+	{"date", func(r Row) any { return r.Date }},
+	{"day_of_week", func(r Row) any { return r.DayOfWeek }},
+	{"day_name", func(r Row) any { return r.DayName }},
+	{"day_of_month", func(r Row) any { return r.DayOfMonth }},
+	{"day_of_year", func(r Row) any { return r.DayOfYear }},
+	{"week_start", func(r Row) any { return r.WeekStart }},
+	{"week_of_year", func(r Row) any { return r.WeekOfYear }},
+	{"iso_year", func(r Row) any { return r.ISOYear }},
+	{"iso_week", func(r Row) any { return r.ISOWeek }},
+	{"month", func(r Row) any { return r.Month }},
+	{"month_name", func(r Row) any { return r.MonthName }},
+	{"month_start", func(r Row) any { return r.MonthStart }},
+	{"quarter", func(r Row) any { return r.Quarter }},
+	{"quarter_start", func(r Row) any { return r.QuarterStart }},
+	{"year", func(r Row) any { return r.Year }},
+	{"year_start", func(r Row) any { return r.YearStart }},
+	{"fiscal_year", func(r Row) any { return r.FiscalYear }},
+	{"fiscal_quarter", func(r Row) any { return r.FiscalQuarter }},
+	{"fiscal_period", func(r Row) any { return r.FiscalPeriod }},
+	{"is_weekend", func(r Row) any { return r.IsWeekend }},
+	{"is_holiday", func(r Row) any { return r.IsHoliday }},
+	{"holiday_name", func(r Row) any { return r.HolidayName }},
+	{"is_business_day", func(r Row) any { return r.IsBusinessDay }},
+	{"business_day_of_month", func(r Row) any { return r.BusinessDayOfMonth }},
+}
+
+// Columns returns the column names in the order they are written
+func Columns() []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// text returns the value as written in CSV, dates are YYYY-MM-DD
+func text(v any) string {
+	switch v := v.(type) {
+	case time.Time:
+		return v.Format(time.DateOnly)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		return v
+	}
+	return fmt.Sprint(v)
+}
+
+// WriteCSV writes the rows as CSV with a header line
+func WriteCSV(w io.Writer, rows []Row) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(Columns()); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, r := range rows {
+		for i, c := range columns {
+			record[i] = text(c.value(r))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSONLines writes each row as a JSON object on its own line
+func WriteJSONLines(w io.Writer, rows []Row) error {
+	bw := bufio.NewWriter(w)
+	for _, r := range rows {
+		bw.WriteByte('{')
+		for i, c := range columns {
+			if i > 0 {
+				bw.WriteByte(',')
+			}
+			v := c.value(r)
+			if t, ok := v.(time.Time); ok {
+				v = t.Format(time.DateOnly)
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(bw, "%q:", c.name)
+			bw.Write(b)
+		}
+		bw.WriteString("}\n")
+	}
+	return bw.Flush()
+}
+
+// sqlBatch is the number of rows in each INSERT statement
+const sqlBatch = 500
+
+// WriteSQL writes the rows as INSERT statements into table
+func WriteSQL(w io.Writer, table string, rows []Row) error {
+	bw := bufio.NewWriter(w)
+	header := fmt.Sprintf("INSERT INTO %s (%s) VALUES\n", table, strings.Join(Columns(), ", "))
+	values := make([]string, len(columns))
+	for i, r := range rows {
+		if i%sqlBatch == 0 {
+			bw.WriteString(header)
+		}
+		for j, c := range columns {
+			values[j] = sqlLiteral(c.value(r))
+		}
+		bw.WriteString("  (" + strings.Join(values, ", ") + ")")
+		if i%sqlBatch == sqlBatch-1 || i == len(rows)-1 {
+			bw.WriteString(";\n")
+		} else {
+			bw.WriteString(",\n")
+		}
+	}
+	return bw.Flush()
+}
+
+// sqlLiteral returns the value as a SQL literal
+func sqlLiteral(v any) string {
+	switch v := v.(type) {
+	case time.Time:
+		return "'" + v.Format(time.DateOnly) + "'"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		if v == "" {
+			return "NULL"
+		}
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	}
+	return fmt.Sprint(v)
+}
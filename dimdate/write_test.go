@@ -0,0 +1,73 @@
+package dimdate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+
+	dates "github.com/hydronica/godates"
+)
+
+// testRows are the first two days of 2024
+func testRows() []Row {
+	return Generate(Config{
+		StartYear: 2024,
+		EndYear:   2024,
+		Week:      dates.NewWeek(time.Sunday, time.Saturday),
+		Calendar:  dates.USFederal,
+	})[:2]
+}
+
+func TestWrite(t *testing.T) {
+	fn := func(format string) (string, error) {
+		var b strings.Builder
+		var err error
+		switch format {
+		case "csv":
+			err = WriteCSV(&b, testRows())
+		case "jsonl":
+			err = WriteJSONLines(&b, testRows())
+		case "sql":
+			err = WriteSQL(&b, "dim_date", testRows())
+		}
+		return b.String(), err
+	}
+
+	cases := trial.Cases[string, string]{
+		"csv": {
+			Input: "csv",
+			Expected: "date_key,date,day_of_week,day_name,day_of_month,day_of_year,week_start,week_of_year,iso_year,iso_week," +
+				"month,month_name,month_start,quarter,quarter_start,year,year_start,fiscal_year,fiscal_quarter,fiscal_period," +
+				"is_weekend,is_holiday,holiday_name,is_business_day,business_day_of_month\n" +
+				"20240101,2024-01-01,1,Monday,1,1,2023-12-31,1,2024,1,1,January,2024-01-01,1,2024-01-01,2024,2024-01-01,2024,1,1,false,true,New Year's Day,false,0\n" +
+				"20240102,2024-01-02,2,Tuesday,2,2,2023-12-31,1,2024,1,1,January,2024-01-01,1,2024-01-01,2024,2024-01-01,2024,1,1,false,false,,true,1\n",
+		},
+		"json lines": {
+			Input: "jsonl",
+			Expected: `{"date_key":20240101,"date":"2024-01-01","day_of_week":1,"day_name":"Monday","day_of_month":1,"day_of_year":1,` +
+				`"week_start":"2023-12-31","week_of_year":1,"iso_year":2024,"iso_week":1,"month":1,"month_name":"January",` +
+				`"month_start":"2024-01-01","quarter":1,"quarter_start":"2024-01-01","year":2024,"year_start":"2024-01-01",` +
+				`"fiscal_year":2024,"fiscal_quarter":1,"fiscal_period":1,"is_weekend":false,"is_holiday":true,` +
+				`"holiday_name":"New Year's Day","is_business_day":false,"business_day_of_month":0}` + "\n" +
+				`{"date_key":20240102,"date":"2024-01-02","day_of_week":2,"day_name":"Tuesday","day_of_month":2,"day_of_year":2,` +
+				`"week_start":"2023-12-31","week_of_year":1,"iso_year":2024,"iso_week":1,"month":1,"month_name":"January",` +
+				`"month_start":"2024-01-01","quarter":1,"quarter_start":"2024-01-01","year":2024,"year_start":"2024-01-01",` +
+				`"fiscal_year":2024,"fiscal_quarter":1,"fiscal_period":1,"is_weekend":false,"is_holiday":false,` +
+				`"holiday_name":"","is_business_day":true,"business_day_of_month":1}` + "\n",
+		},
+		"sql": {
+			Input: "sql",
+			Expected: "INSERT INTO dim_date (date_key, date, day_of_week, day_name, day_of_month, day_of_year, week_start, week_of_year, " +
+				"iso_year, iso_week, month, month_name, month_start, quarter, quarter_start, year, year_start, fiscal_year, " +
+				"fiscal_quarter, fiscal_period, is_weekend, is_holiday, holiday_name, is_business_day, business_day_of_month) VALUES\n" +
+				"  (20240101, '2024-01-01', 1, 'Monday', 1, 1, '2023-12-31', 1, 2024, 1, 1, 'January', '2024-01-01', 1, '2024-01-01', " +
+				"2024, '2024-01-01', 2024, 1, 1, FALSE, TRUE, 'New Year''s Day', FALSE, 0),\n" +
+				"  (20240102, '2024-01-02', 2, 'Tuesday', 2, 2, '2023-12-31', 1, 2024, 1, 1, 'January', '2024-01-01', 1, '2024-01-01', " +
+				"2024, '2024-01-01', 2024, 1, 1, FALSE, FALSE, NULL, TRUE, 1);\n",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
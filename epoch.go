@@ -0,0 +1,108 @@
+package dates
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// unixEpoch is the julian day number of 1970-01-01
+const unixEpoch = 2440588
+
+const msPerDay = 24 * 60 * 60 * 1000
+
+// ToEpochDay returns the number of days from 1970-01-01 to the day of t
+func ToEpochDay(t time.Time) int {
+	return int(floorDiv64(Day(t).Unix(), 24*60*60))
+}
+
+// FromEpochDay returns the date that is days after 1970-01-01
+func FromEpochDay(days int) time.Time {
+	return Date(1970, time.January, 1+days)
+}
+
+// JulianDayNumber returns the julian day number of the day of t,
+// the number of days since Jan 1st, 4713 BC (proleptic Julian calendar) starting at noon
+func JulianDayNumber(t time.Time) int {
+	return ToEpochDay(t) + unixEpoch
+}
+
+// FromJulianDayNumber returns the date of the julian day number
+func FromJulianDayNumber(jdn int) time.Time {
+	return FromEpochDay(jdn - unixEpoch)
+}
+
+// ToJulianDay returns the julian day of t including the time of day,
+// i.e., 2451545.0 is 2000-01-01 12:00 UTC
+func ToJulianDay(t time.Time) float64 {
+	return float64(t.UnixMilli())/msPerDay + unixEpoch - 0.5
+}
+
+// FromJulianDay returns the UTC time of the julian day to the millisecond
+func FromJulianDay(jd float64) time.Time {
+	return time.UnixMilli(int64(math.Round((jd - unixEpoch + 0.5) * msPerDay))).UTC()
+}
+
+// ExcelSystem is the date system of an Excel workbook
+type ExcelSystem int
+
+const (
+	// Excel1900 counts 1900-01-01 as day 1 and keeps Lotus 1-2-3's Feb 29th, 1900 as day 60
+	Excel1900 ExcelSystem = iota
+	// Excel1904 counts 1904-01-01 as day 0, the default of early Mac versions
+	Excel1904
+)
+
+var (
+	excel1900 = Date(1899, time.December, 30) // day 0 for dates from 1900-03-01 on
+	excel1904 = Date(1904, time.January, 1)
+)
+
+// ToExcelSerial returns the Excel serial date of t with the time of day as the fraction
+func ToExcelSerial(t time.Time, system ExcelSystem) float64 {
+	start := excel1904
+	if system == Excel1900 {
+		start = excel1900
+		if t.Before(Date(1900, time.March, 1)) {
+			start = start.Add(OneDay) // no Feb 29th
+		}
+	}
+	h, m, s := t.Clock()
+	clock := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second +
+		time.Duration(t.Nanosecond())
+	return float64(ToEpochDay(t)-ToEpochDay(start)) + float64(clock.Milliseconds())/msPerDay
+}
+
+// FromExcelSerial returns the UTC time of the Excel serial date. An error is returned
+// for negative serials and for day 60 of the 1900 system that does not exist.
+func FromExcelSerial(serial float64, system ExcelSystem) (time.Time, error) {
+	if serial < 0 || math.IsNaN(serial) || math.IsInf(serial, 0) {
+		return time.Time{}, fmt.Errorf("invalid excel serial %v", serial)
+	}
+	days := int(serial)
+	start := excel1904
+	if system == Excel1900 {
+		start = excel1900
+		switch {
+		case days == 60:
+			return time.Time{}, fmt.Errorf("excel serial 60 is Feb 29th, 1900 that does not exist")
+		case days < 60:
+			start = start.Add(OneDay)
+		}
+	}
+	clock := time.Duration(math.Round((serial-float64(days))*msPerDay)) * time.Millisecond
+	return start.AddDate(0, 0, days).Add(clock), nil
+}
+
+// floorDiv divides rounding toward negative infinity
+func floorDiv(a, b int) int {
+	return int(floorDiv64(int64(a), int64(b)))
+}
+
+func floorDiv64(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
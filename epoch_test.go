@@ -0,0 +1,113 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestEpochDay(t *testing.T) {
+	fn := func(in time.Time) (int, error) {
+		return ToEpochDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, int]{
+		"epoch":     {Input: Date(1970, 1, 1), Expected: 0},
+		"before":    {Input: Date(1969, 12, 31), Expected: -1},
+		"with time": {Input: time.Date(2024, 1, 1, 23, 59, 0, 0, time.UTC), Expected: 19723},
+		"far":       {Input: Date(2500, 1, 1), Expected: 193579},
+		"year 1":    {Input: Date(1, 1, 1), Expected: -719162},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	for _, d := range []time.Time{Date(1, 1, 1), Date(1900, 2, 28), Date(2024, 2, 29), Date(9999, 12, 31)} {
+		if got := FromEpochDay(ToEpochDay(d)); !got.Equal(d) {
+			t.Errorf("round trip %v: got %v", d, got)
+		}
+	}
+}
+
+func TestJulianDay(t *testing.T) {
+	fn := func(in time.Time) (float64, error) {
+		return ToJulianDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, float64]{
+		"J2000":    {Input: time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), Expected: 2451545},
+		"midnight": {Input: Date(2000, 1, 1), Expected: 2451544.5},
+		"unix":     {Input: Date(1970, 1, 1), Expected: 2440587.5},
+		"sputnik":  {Input: time.Date(1957, 10, 4, 19, 12, 0, 0, time.UTC), Expected: 2436116.3},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if got := JulianDayNumber(Date(2000, 1, 1)); got != 2451545 {
+		t.Errorf("julian day number got %d", got)
+	}
+	if got := FromJulianDayNumber(2451545); !got.Equal(Date(2000, 1, 1)) {
+		t.Errorf("from julian day number got %v", got)
+	}
+	in := time.Date(2024, 7, 4, 18, 30, 15, 250e6, time.UTC)
+	if got := FromJulianDay(ToJulianDay(in)); !got.Equal(in) {
+		t.Errorf("round trip got %v", got)
+	}
+}
+
+func TestToExcelSerial(t *testing.T) {
+	type input struct {
+		t      time.Time
+		system ExcelSystem
+	}
+	fn := func(in input) (float64, error) {
+		return ToExcelSerial(in.t, in.system), nil
+	}
+
+	cases := trial.Cases[input, float64]{
+		"1900 day 1":     {Input: input{Date(1900, 1, 1), Excel1900}, Expected: 1},
+		"before leap":    {Input: input{Date(1900, 2, 28), Excel1900}, Expected: 59},
+		"after leap":     {Input: input{Date(1900, 3, 1), Excel1900}, Expected: 61},
+		"2024":           {Input: input{Date(2024, 1, 1), Excel1900}, Expected: 45292},
+		"noon":           {Input: input{time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), Excel1900}, Expected: 45292.5},
+		"local time":     {Input: input{time.Date(2024, 1, 1, 18, 0, 0, 0, time.FixedZone("", -5*3600)), Excel1900}, Expected: 45292.75},
+		"1904 day 0":     {Input: input{Date(1904, 1, 1), Excel1904}, Expected: 0},
+		"1904 2024":      {Input: input{Date(2024, 1, 1), Excel1904}, Expected: 43830},
+		"1900 1904 base": {Input: input{Date(1904, 1, 1), Excel1900}, Expected: 1462},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFromExcelSerial(t *testing.T) {
+	type input struct {
+		serial float64
+		system ExcelSystem
+	}
+	fn := func(in input) (time.Time, error) {
+		return FromExcelSerial(in.serial, in.system)
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"1900 day 1":  {Input: input{1, Excel1900}, Expected: Date(1900, 1, 1)},
+		"before leap": {Input: input{59, Excel1900}, Expected: Date(1900, 2, 28)},
+		"fake leap":   {Input: input{60, Excel1900}, ShouldErr: true},
+		"after leap":  {Input: input{61, Excel1900}, Expected: Date(1900, 3, 1)},
+		"with time":   {Input: input{45292.75, Excel1900}, Expected: time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)},
+		"1904":        {Input: input{43830, Excel1904}, Expected: Date(2024, 1, 1)},
+		"negative":    {Input: input{-1, Excel1904}, ShouldErr: true},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	for _, d := range []time.Time{Date(1900, 1, 1), Date(1900, 3, 1), Date(2024, 2, 29)} {
+		for _, system := range []ExcelSystem{Excel1900, Excel1904} {
+			if d.Year() < 1904 && system == Excel1904 {
+				continue
+			}
+			if got, err := FromExcelSerial(ToExcelSerial(d, system), system); err != nil || !got.Equal(d) {
+				t.Errorf("round trip %v: got %v %v", d, got, err)
+			}
+		}
+	}
+}
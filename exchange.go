@@ -0,0 +1,151 @@
+package dates
+
+import (
+	"slices"
+	"time"
+	_ "time/tzdata" // the exchange time zones do not depend on the system time zone database
+)
+
+// Exchange is the trading calendar of a stock exchange with its session hours.
+// The embedded Calendar has the days the exchange is closed.
+type Exchange struct {
+	Calendar
+	Location    *time.Location // time zone of the session hours
+	Open, Close time.Duration  // regular session as the time of day, i.e., 9h30m to 16h
+	EarlyClose  time.Duration  // close of an early close day
+	EarlyCloses []Holiday      // days the exchange closes early when they are trading days
+}
+
+// specialClosure is a single day the exchange was closed for an unscheduled event
+func specialClosure(year int, month time.Month, day int) HolidayFunc {
+	return func(date time.Time) time.Time {
+		if date.Year() != year {
+			return time.Time{}
+		}
+		return Date(year, month, day)
+	}
+}
+
+// exchangeObserved is Observed with the NYSE rule 7.2 exception that a Saturday holiday
+// is not observed on Friday at the end of a month, so New Year's Day on Saturday is not a holiday
+func exchangeObserved(fn HolidayFunc) HolidayFunc {
+	return shiftHoliday(fn, func(d time.Time) time.Time {
+		t := observed(d)
+		if d.Weekday() == time.Saturday && t.Month() != d.Month() {
+			return time.Time{}
+		}
+		return t
+	})
+}
+
+// loadLocation returns the time zone by name, it panics for an unknown name
+func loadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// usExchange returns a calendar with the holidays and early closes of the NYSE and NASDAQ.
+// The exchanges close on the federal holidays except Columbus and Veterans Day, and on Good Friday.
+func usExchange(name string) Exchange {
+	return Exchange{
+		Calendar: NewCalendar(name,
+			Holiday{"New Year's Day", exchangeObserved(NewYearsDay)},
+			Holiday{"Martin Luther King Jr. Day", Since(1998, MartinLutherKingJrDay)},
+			Holiday{"Washington's Birthday", WashingtonsBirthday},
+			Holiday{"Good Friday", GoodFriday},
+			Holiday{"Memorial Day", MemorialDay},
+			Holiday{"Juneteenth", exchangeObserved(Since(2022, Juneteenth))},
+			Holiday{"Independence Day", exchangeObserved(IndependenceDay)},
+			Holiday{"Labor Day", LaborDay},
+			Holiday{"Thanksgiving Day", ThanksgivingDay},
+			Holiday{"Christmas Day", exchangeObserved(ChristmasDay)},
+			Holiday{"September 11 Attacks", specialClosure(2001, time.September, 11)},
+			Holiday{"September 11 Attacks", specialClosure(2001, time.September, 12)},
+			Holiday{"September 11 Attacks", specialClosure(2001, time.September, 13)},
+			Holiday{"September 11 Attacks", specialClosure(2001, time.September, 14)},
+			Holiday{"Funeral of Ronald Reagan", specialClosure(2004, time.June, 11)},
+			Holiday{"Funeral of Gerald Ford", specialClosure(2007, time.January, 2)},
+			Holiday{"Hurricane Sandy", specialClosure(2012, time.October, 29)},
+			Holiday{"Hurricane Sandy", specialClosure(2012, time.October, 30)},
+			Holiday{"Funeral of George H.W. Bush", specialClosure(2018, time.December, 5)},
+			Holiday{"Funeral of Jimmy Carter", specialClosure(2025, time.January, 9)},
+		),
+		Location:   loadLocation("America/New_York"),
+		Open:       9*time.Hour + 30*time.Minute,
+		Close:      16 * time.Hour,
+		EarlyClose: 13 * time.Hour,
+		EarlyCloses: []Holiday{
+			{"Independence Day Eve", mustRule("fixed 07-03")},
+			{"Day after Thanksgiving", mustRule("4th thursday november +1")},
+			{"Christmas Eve", ChristmasEve},
+		},
+	}
+}
+
+var (
+	// NYSE is the New York Stock Exchange trading calendar, registered as "nyse"
+	NYSE = usExchange("nyse")
+
+	// NASDAQ is the Nasdaq trading calendar, registered as "nasdaq"
+	NASDAQ = usExchange("nasdaq")
+)
+
+func init() {
+	RegisterCalendar(NYSE.Calendar)
+	RegisterCalendar(NASDAQ.Calendar)
+}
+
+// IsTradingDay reports whether the exchange is open on the day of t
+func (e Exchange) IsTradingDay(t time.Time) bool {
+	return e.IsBusinessDay(t)
+}
+
+// NextTradingDay returns the first trading day after t
+func (e Exchange) NextTradingDay(t time.Time) time.Time {
+	return e.NextBusinessDay(t)
+}
+
+// PrevTradingDay returns the last trading day before t
+func (e Exchange) PrevTradingDay(t time.Time) time.Time {
+	return e.PrevBusinessDay(t)
+}
+
+// TradingDaysBetween returns the number of trading days after a up to and including b,
+// the time of day is ignored. The value is negative when b is before a
+func (e Exchange) TradingDaysBetween(a, b time.Time) int {
+	if b.Before(a) {
+		return -e.TradingDaysBetween(b, a)
+	}
+	return e.BusinessDays(NewRange(Day(a).Add(OneDay), b))
+}
+
+// IsEarlyClose reports whether the exchange closes early on the day of t
+func (e Exchange) IsEarlyClose(t time.Time) bool {
+	if !e.IsTradingDay(t) {
+		return false
+	}
+	return slices.ContainsFunc(e.EarlyCloses, func(h Holiday) bool {
+		return slices.ContainsFunc(h.dates(t.Year()), Day(t).Equal)
+	})
+}
+
+// SessionHours returns the open and close of the session on the day of t in the exchange's time zone,
+// the zero times are returned when the day is not a trading day
+func (e Exchange) SessionHours(t time.Time) (open, close time.Time) {
+	if !e.IsTradingDay(t) {
+		return time.Time{}, time.Time{}
+	}
+	loc := e.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	end := e.Close
+	if e.IsEarlyClose(t) {
+		end = e.EarlyClose
+	}
+	return day.Add(e.Open), day.Add(end)
+}
@@ -0,0 +1,82 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestIsTradingDay(t *testing.T) {
+	fn := func(in time.Time) (bool, error) {
+		return NYSE.IsTradingDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, bool]{
+		"regular day":        {Input: Date(2024, 3, 27), Expected: true},
+		"weekend":            {Input: Date(2024, 3, 30), Expected: false},
+		"good friday":        {Input: Date(2024, 3, 29), Expected: false},
+		"columbus day":       {Input: Date(2024, 10, 14), Expected: true},
+		"veterans day":       {Input: Date(2024, 11, 11), Expected: true},
+		"juneteenth":         {Input: Date(2024, 6, 19), Expected: false},
+		"juneteenth 2021":    {Input: Date(2021, 6, 18), Expected: true},
+		"observed christmas": {Input: Date(2021, 12, 24), Expected: false},
+		"saturday new year":  {Input: Date(2021, 12, 31), Expected: true},
+		"observed july 4th":  {Input: Date(2020, 7, 3), Expected: false},
+		"september 11th":     {Input: Date(2001, 9, 13), Expected: false},
+		"hurricane sandy":    {Input: Date(2012, 10, 30), Expected: false},
+		"jimmy carter":       {Input: Date(2025, 1, 9), Expected: false},
+		"mlk before 1998":    {Input: Date(1997, 1, 20), Expected: true},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestNextTradingDay(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return NYSE.NextTradingDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"next day":      {Input: Date(2024, 3, 27), Expected: Date(2024, 3, 28)},
+		"good friday":   {Input: Date(2024, 3, 28), Expected: Date(2024, 4, 1)},
+		"thanksgiving":  {Input: Date(2024, 11, 27), Expected: Date(2024, 11, 29)},
+		"closed a week": {Input: Date(2001, 9, 10), Expected: Date(2001, 9, 17)},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestTradingDaysBetween(t *testing.T) {
+	fn := func(in Range) (int, error) {
+		return NASDAQ.TradingDaysBetween(in.Start, in.End), nil
+	}
+	cases := trial.Cases[Range, int]{
+		"same day":    {Input: NewRange(Date(2024, 3, 27), Date(2024, 3, 27)), Expected: 0},
+		"good friday": {Input: NewRange(Date(2024, 3, 27), Date(2024, 4, 1)), Expected: 2},
+		"reversed":    {Input: NewRange(Date(2024, 4, 1), Date(2024, 3, 27)), Expected: -2},
+		"year 2024":   {Input: NewRange(Date(2023, 12, 31), Date(2024, 12, 31)), Expected: 252},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestSessionHours(t *testing.T) {
+	type output struct {
+		Open, Close string
+	}
+	fn := func(in time.Time) (output, error) {
+		open, close := NYSE.SessionHours(in)
+		if open.IsZero() {
+			return output{}, nil
+		}
+		return output{open.Format(time.RFC3339), close.Format(time.RFC3339)}, nil
+	}
+	cases := trial.Cases[time.Time, output]{
+		"regular":         {Input: Date(2024, 3, 27), Expected: output{"2024-03-27T09:30:00-04:00", "2024-03-27T16:00:00-04:00"}},
+		"winter":          {Input: Date(2024, 1, 2), Expected: output{"2024-01-02T09:30:00-05:00", "2024-01-02T16:00:00-05:00"}},
+		"july 3rd":        {Input: Date(2024, 7, 3), Expected: output{"2024-07-03T09:30:00-04:00", "2024-07-03T13:00:00-04:00"}},
+		"black friday":    {Input: Date(2024, 11, 29), Expected: output{"2024-11-29T09:30:00-05:00", "2024-11-29T13:00:00-05:00"}},
+		"christmas eve":   {Input: Date(2024, 12, 24), Expected: output{"2024-12-24T09:30:00-05:00", "2024-12-24T13:00:00-05:00"}},
+		"closed":          {Input: Date(2024, 12, 25), Expected: output{}},
+		"july 3rd closed": {Input: Date(2020, 7, 3), Expected: output{}},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
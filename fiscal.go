@@ -0,0 +1,77 @@
+package dates
+
+import (
+	"time"
+)
+
+// Fiscal defines a fiscal year by the month it starts in,
+// for example the US government fiscal year starts in October
+type Fiscal struct {
+	StartMonth time.Month // first month of the fiscal year, January when not set
+
+	// NamedByStart names the fiscal year by the calendar year it starts in.
+	// By default a fiscal year is named by the year it ends in,
+	// i.e., FY2024 is Oct 2023 to Sep 2024
+	NamedByStart bool
+}
+
+// NewFiscal returns a fiscal year starting in the given month named by the year it ends in
+func NewFiscal(start time.Month) Fiscal {
+	return Fiscal{StartMonth: start}
+}
+
+func (f Fiscal) start() time.Month {
+	if f.StartMonth < time.January || f.StartMonth > time.December {
+		return time.January
+	}
+	return f.StartMonth
+}
+
+// Period returns the fiscal month (1-12) of t
+func (f Fiscal) Period(t time.Time) int {
+	return (int(t.Month())-int(f.start())+12)%12 + 1
+}
+
+// Quarter returns the fiscal quarter (1-4) of t
+func (f Fiscal) Quarter(t time.Time) int {
+	return (f.Period(t)-1)/3 + 1
+}
+
+// Year returns the fiscal year of t
+func (f Fiscal) Year(t time.Time) int {
+	start := f.StartOfYear(t)
+	if f.NamedByStart || f.start() == time.January {
+		return start.Year()
+	}
+	return start.Year() + 1
+}
+
+// StartOfYear returns the first day of the fiscal year of t
+func (f Fiscal) StartOfYear(t time.Time) time.Time {
+	year := t.Year()
+	if t.Month() < f.start() {
+		year--
+	}
+	return Date(year, f.start(), 1)
+}
+
+// FullYear returns the start and end dates of the fiscal year of t
+func (f Fiscal) FullYear(t time.Time) (start, end time.Time) {
+	start = f.StartOfYear(t)
+	end = Date(start.Year()+1, start.Month(), 0)
+	return start, end
+}
+
+// FullQuarter returns the start and end dates of the fiscal quarter of t
+func (f Fiscal) FullQuarter(t time.Time) (start, end time.Time) {
+	start = MonthAdd(f.StartOfYear(t), (f.Quarter(t)-1)*3)
+	end = Date(start.Year(), start.Month()+3, 0)
+	return start, end
+}
+
+// YearToDate returns the start of the fiscal year up to the given date t
+func (f Fiscal) YearToDate(t time.Time) (start, end time.Time) {
+	start = f.StartOfYear(t)
+	end = t
+	return start, end
+}
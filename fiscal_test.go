@@ -0,0 +1,90 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestFiscal(t *testing.T) {
+	type input struct {
+		fiscal Fiscal
+		date   time.Time
+	}
+	type result struct {
+		year    int
+		quarter int
+		period  int
+	}
+	fn := func(in input) (result, error) {
+		return result{
+			year:    in.fiscal.Year(in.date),
+			quarter: in.fiscal.Quarter(in.date),
+			period:  in.fiscal.Period(in.date),
+		}, nil
+	}
+
+	cases := trial.Cases[input, result]{
+		"calendar year": {
+			Input:    input{Fiscal{}, Date(2024, 5, 15)},
+			Expected: result{2024, 2, 5},
+		},
+		"us government start": {
+			Input:    input{NewFiscal(time.October), Date(2023, 10, 1)},
+			Expected: result{2024, 1, 1},
+		},
+		"us government end": {
+			Input:    input{NewFiscal(time.October), Date(2024, 9, 30)},
+			Expected: result{2024, 4, 12},
+		},
+		"named by start": {
+			Input:    input{Fiscal{StartMonth: time.April, NamedByStart: true}, Date(2025, 2, 1)},
+			Expected: result{2024, 4, 11},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFiscalFullQuarter(t *testing.T) {
+	f := NewFiscal(time.July)
+	fn := func(in time.Time) (output, error) {
+		start, end := f.FullQuarter(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"first quarter": {
+			Input:    Date(2024, 8, 15),
+			Expected: output{start: Date(2024, 7, 1), end: Date(2024, 9, 30)},
+		},
+		"third quarter": {
+			Input:    Date(2025, 2, 28),
+			Expected: output{start: Date(2025, 1, 1), end: Date(2025, 3, 31)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFiscalFullYear(t *testing.T) {
+	f := NewFiscal(time.October)
+	fn := func(in time.Time) (output, error) {
+		start, end := f.FullYear(in)
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[time.Time, output]{
+		"before start month": {
+			Input:    Date(2024, 2, 29),
+			Expected: output{start: Date(2023, 10, 1), end: Date(2024, 9, 30)},
+		},
+		"start month": {
+			Input:    Date(2024, 10, 1),
+			Expected: output{start: Date(2024, 10, 1), end: Date(2025, 9, 30)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
@@ -0,0 +1,84 @@
+package dates
+
+import (
+	"strings"
+	"time"
+)
+
+// RangeStyle is the style used to write the dates of a formatted range
+type RangeStyle int
+
+const (
+	ShortStyle   RangeStyle = iota // Jan 29 – Feb 4, 2024
+	LongStyle                      // January 29 – February 4, 2024
+	NumericStyle                   // 1/29/2024 – 2/4/2024
+)
+
+// rangeLayouts are the time layouts used to write a range, parts shared
+// by the start and end dates are only written once
+type rangeLayouts struct {
+	date      string    // a single date
+	sameMonth [2]string // start and end layouts when both dates are in the same month
+	sameYear  [2]string // start and end layouts when both dates are in the same year
+	numeric   string    // a single date in NumericStyle
+	sep       string    // separator between two dates
+	daySep    string    // separator between two days of the same month
+}
+
+// FormatRange returns the range as text, collapsing the year and month when
+// the start and end dates share them, for example
+//
+//	Feb 1–29, 2024
+//	Jan 29 – Feb 4, 2024
+//	Dec 25, 2023 – Jan 1, 2024
+func FormatRange(r Range, style RangeStyle) string {
+	return English.FormatRange(r, style)
+}
+
+// format writes the range using the layouts and the format func of a locale
+func (l rangeLayouts) format(r Range, style RangeStyle, format func(t time.Time, layout string) string) string {
+	start, end := Day(r.Start), Day(r.End)
+	if style == NumericStyle {
+		if start.Equal(end) {
+			return format(start, l.numeric)
+		}
+		return format(start, l.numeric) + l.sep + format(end, l.numeric)
+	}
+
+	layout := func(s string) string {
+		if style == LongStyle {
+			return strings.ReplaceAll(s, "Jan", "January")
+		}
+		return s
+	}
+
+	switch {
+	case start.Equal(end):
+		return format(start, layout(l.date))
+	case start.Year() == end.Year() && start.Month() == end.Month():
+		return format(start, layout(l.sameMonth[0])) + l.daySep + format(end, layout(l.sameMonth[1]))
+	case start.Year() == end.Year():
+		return format(start, layout(l.sameYear[0])) + l.sep + format(end, layout(l.sameYear[1]))
+	}
+	return format(start, layout(l.date)) + l.sep + format(end, layout(l.date))
+}
+
+// WeekNumber returns the year and week number of t.
+// Weeks starting on Monday use the ISO 8601 week number,
+// otherwise week 1 is the week containing January 1st.
+func (d Week) WeekNumber(t time.Time) (year, week int) {
+	if d.weekStart == time.Monday {
+		return t.ISOWeek()
+	}
+	start := d.StartOfWeek(t)
+	year = start.Add(OneDay * 6).Year()
+	first := d.StartOfWeek(Date(year, 1, 1))
+	return year, DaysBetween(first, start)/7 + 1
+}
+
+// PeriodLabel returns a label for the range when it is exactly a week, month, quarter or year,
+// i.e., "Week 5, 2024", "February 2024", "Q1 2024" or "2024".
+// false is returned if the range doesn't match a known period.
+func (d Week) PeriodLabel(r Range) (string, bool) {
+	return English.PeriodLabel(d, r)
+}
@@ -0,0 +1,127 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestFormatRange(t *testing.T) {
+	type input struct {
+		r     Range
+		style RangeStyle
+	}
+	fn := func(in input) (string, error) {
+		return FormatRange(in.r, in.style), nil
+	}
+
+	lfw := NewRange(NewWeek(time.Monday, time.Sunday).LastFullWeek(Date(2024, 2, 5)))
+	cases := trial.Cases[input, string]{
+		"same month": {
+			Input:    input{NewRange(FullMonth(Date(2024, 2, 1))), ShortStyle},
+			Expected: "Feb 1–29, 2024",
+		},
+		"same year": {
+			Input:    input{lfw, ShortStyle},
+			Expected: "Jan 29 – Feb 4, 2024",
+		},
+		"different years": {
+			Input:    input{NewRange(Date(2023, 12, 25), Date(2024, 1, 1)), ShortStyle},
+			Expected: "Dec 25, 2023 – Jan 1, 2024",
+		},
+		"single day": {
+			Input:    input{NewRange(Date(2024, 7, 4), Date(2024, 7, 4)), ShortStyle},
+			Expected: "Jul 4, 2024",
+		},
+		"long": {
+			Input:    input{lfw, LongStyle},
+			Expected: "January 29 – February 4, 2024",
+		},
+		"long same month": {
+			Input:    input{NewRange(FullMonth(Date(2024, 2, 1))), LongStyle},
+			Expected: "February 1–29, 2024",
+		},
+		"numeric": {
+			Input:    input{lfw, NumericStyle},
+			Expected: "1/29/2024 – 2/4/2024",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWeekNumber(t *testing.T) {
+	type input struct {
+		week Week
+		date time.Time
+	}
+	type result struct {
+		year int
+		week int
+	}
+	fn := func(in input) (result, error) {
+		y, w := in.week.WeekNumber(in.date)
+		return result{y, w}, nil
+	}
+
+	mon := NewWeek(time.Monday, time.Sunday)
+	sun := NewWeek(time.Sunday, time.Saturday)
+	cases := trial.Cases[input, result]{
+		"iso week": {
+			Input:    input{mon, Date(2024, 1, 31)},
+			Expected: result{2024, 5},
+		},
+		"iso week previous year": {
+			Input:    input{mon, Date(2021, 1, 1)},
+			Expected: result{2020, 53},
+		},
+		"sunday week": {
+			Input:    input{sun, Date(2024, 1, 31)},
+			Expected: result{2024, 5},
+		},
+		"sunday week next year": {
+			Input:    input{sun, Date(2024, 12, 30)},
+			Expected: result{2025, 1},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPeriodLabel(t *testing.T) {
+	d := NewWeek(time.Monday, time.Sunday)
+	type result struct {
+		label string
+		ok    bool
+	}
+	fn := func(in Range) (result, error) {
+		l, ok := d.PeriodLabel(in)
+		return result{l, ok}, nil
+	}
+
+	cases := trial.Cases[Range, result]{
+		"week": {
+			Input:    NewRange(d.LastFullWeek(Date(2024, 2, 5))),
+			Expected: result{"Week 5, 2024", true},
+		},
+		"month": {
+			Input:    NewRange(PrevMonth(Date(2024, 3, 5))),
+			Expected: result{"February 2024", true},
+		},
+		"quarter": {
+			Input:    NewRange(FullQuarter(Date(2024, 3, 5))),
+			Expected: result{"Q1 2024", true},
+		},
+		"year": {
+			Input:    NewRange(PrevYear(Date(2024, 3, 5))),
+			Expected: result{"2023", true},
+		},
+		"month to date": {
+			Input:    NewRange(MonthToDate(Date(2024, 3, 5))),
+			Expected: result{"", false},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
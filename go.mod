@@ -2,6 +2,9 @@ module github.com/hydronica/godates
 
 go 1.21.6
 
-require github.com/hydronica/trial v0.7.2
+require (
+	github.com/hydronica/trial v0.7.2
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require github.com/google/go-cmp v0.6.0 // indirect
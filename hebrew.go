@@ -0,0 +1,206 @@
+package dates
+
+import (
+	"fmt"
+	"time"
+)
+
+// HebrewMonth is a month of the Hebrew calendar numbered from Nisan,
+// the year starts on 1 Tishri and leap years have a 13th month Adar II
+type HebrewMonth int
+
+const (
+	Nisan HebrewMonth = iota + 1
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishri
+	Marheshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar // Adar I in a leap year
+	AdarII
+)
+
+var hebrewMonths = [13]string{
+	"Nisan", "Iyyar", "Sivan", "Tammuz", "Av", "Elul",
+	"Tishri", "Marheshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II",
+}
+
+// String returns the name of the month
+func (m HebrewMonth) String() string {
+	if m < Nisan || m > AdarII {
+		return fmt.Sprintf("%%!HebrewMonth(%d)", int(m))
+	}
+	return hebrewMonths[m-1]
+}
+
+// Hebrew is a date in the Hebrew calendar
+type Hebrew struct {
+	Year  int
+	Month HebrewMonth
+	Day   int
+}
+
+// String returns the date as "15 Nisan 5784"
+func (h Hebrew) String() string {
+	return fmt.Sprintf("%d %s %d", h.Day, h.Month, h.Year)
+}
+
+const (
+	hebrewEpoch = -1373427 // fixed day of 1 Tishri 1 (Oct 7th, 3761 BCE)
+	fixedEpoch  = 719163   // fixed day of 1970-01-01 counting Jan 1st, 1 as day 1
+)
+
+// The Hebrew calendar is calculated with the arithmetic of Dershowitz and Reingold's
+// Calendrical Calculations using fixed days counted from Jan 1st, 1 CE
+func toFixed(t time.Time) int {
+	return ToEpochDay(t) + fixedEpoch
+}
+
+func fromFixed(fixed int) time.Time {
+	return FromEpochDay(fixed - fixedEpoch)
+}
+
+// IsHebrewLeapYear reports whether the Hebrew year has the leap month Adar II
+func IsHebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
+
+func lastHebrewMonth(year int) HebrewMonth {
+	if IsHebrewLeapYear(year) {
+		return AdarII
+	}
+	return Adar
+}
+
+// hebrewElapsedDays is the number of days to the molad of Tishri of the year
+// delayed when it falls on a Sunday, Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// hebrewNewYear is the fixed day of 1 Tishri of the year
+func hebrewNewYear(year int) int {
+	ny0, ny1, ny2 := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	correction := 0
+	if ny2-ny1 == 356 {
+		correction = 2
+	} else if ny1-ny0 == 382 {
+		correction = 1
+	}
+	return hebrewEpoch + ny1 + correction
+}
+
+// HebrewMonthDays returns the number of days in the month of the Hebrew year
+func HebrewMonthDays(year int, month HebrewMonth) int {
+	days := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == Iyyar, month == Tammuz, month == Elul, month == Tevet, month == AdarII,
+		month == Adar && !IsHebrewLeapYear(year),
+		month == Marheshvan && days != 355 && days != 385,
+		month == Kislev && (days == 353 || days == 383):
+		return 29
+	}
+	return 30
+}
+
+func (h Hebrew) fixed() int {
+	fixed := hebrewNewYear(h.Year) + h.Day - 1
+	if h.Month < Tishri {
+		for m := Tishri; m <= lastHebrewMonth(h.Year); m++ {
+			fixed += HebrewMonthDays(h.Year, m)
+		}
+		for m := Nisan; m < h.Month; m++ {
+			fixed += HebrewMonthDays(h.Year, m)
+		}
+		return fixed
+	}
+	for m := Tishri; m < h.Month; m++ {
+		fixed += HebrewMonthDays(h.Year, m)
+	}
+	return fixed
+}
+
+// FromHebrew returns the Gregorian date of the Hebrew date h
+func FromHebrew(h Hebrew) time.Time {
+	return fromFixed(h.fixed())
+}
+
+// ToHebrew returns the Hebrew date of t, the Hebrew day starts at sundown
+// the evening before which is ignored
+func ToHebrew(t time.Time) Hebrew {
+	fixed := toFixed(t)
+	year := int(float64(fixed-hebrewEpoch)/(35975351.0/98496.0)) + 1
+	for hebrewNewYear(year) > fixed {
+		year--
+	}
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+
+	month := Tishri
+	if fixed >= (Hebrew{Year: year, Month: Nisan, Day: 1}).fixed() {
+		month = Nisan
+	}
+	for fixed > (Hebrew{Year: year, Month: month, Day: HebrewMonthDays(year, month)}).fixed() {
+		month++
+	}
+	return Hebrew{Year: year, Month: month, Day: fixed - (Hebrew{Year: year, Month: month, Day: 1}).fixed() + 1}
+}
+
+// hebrewHoliday returns a holiday on the Hebrew month and day in the Gregorian year of date.
+// Jewish holidays begin at sundown the evening before the date returned.
+func hebrewHoliday(month HebrewMonth, day int) HolidayFunc {
+	return func(date time.Time) time.Time {
+		// Tishri to Elul starts in the fall, months from Nisan on are in the spring of the next Gregorian year
+		year := date.Year() + 3761
+		if month < Tishri {
+			year--
+		}
+		return FromHebrew(Hebrew{Year: year, Month: month, Day: day})
+	}
+}
+
+// RoshHashanah is 1 Tishri, the Jewish new year
+func RoshHashanah(date time.Time) time.Time {
+	return hebrewHoliday(Tishri, 1)(date)
+}
+
+// YomKippur is 10 Tishri
+func YomKippur(date time.Time) time.Time {
+	return hebrewHoliday(Tishri, 10)(date)
+}
+
+// Sukkot is the first day of Sukkot on 15 Tishri
+func Sukkot(date time.Time) time.Time {
+	return hebrewHoliday(Tishri, 15)(date)
+}
+
+// Hanukkah is the first day of Hanukkah on 25 Kislev
+func Hanukkah(date time.Time) time.Time {
+	return hebrewHoliday(Kislev, 25)(date)
+}
+
+// Passover is the first day of Passover on 15 Nisan
+func Passover(date time.Time) time.Time {
+	return hebrewHoliday(Nisan, 15)(date)
+}
+
+// Shavuot is 6 Sivan
+func Shavuot(date time.Time) time.Time {
+	return hebrewHoliday(Sivan, 6)(date)
+}
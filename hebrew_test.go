@@ -0,0 +1,84 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestToHebrew(t *testing.T) {
+	fn := func(in time.Time) (Hebrew, error) {
+		return ToHebrew(in), nil
+	}
+
+	cases := trial.Cases[time.Time, Hebrew]{
+		"rosh hashanah": {Input: Date(2024, 10, 3), Expected: Hebrew{5785, Tishri, 1}},
+		"end of year":   {Input: Date(2024, 10, 2), Expected: Hebrew{5784, Elul, 29}},
+		"adar I":        {Input: Date(2024, 2, 10), Expected: Hebrew{5784, Adar, 1}},
+		"adar II":       {Input: Date(2024, 3, 24), Expected: Hebrew{5784, AdarII, 14}},
+		"adar":          {Input: Date(2025, 3, 14), Expected: Hebrew{5785, Adar, 14}},
+		"passover":      {Input: Date(2024, 4, 23), Expected: Hebrew{5784, Nisan, 15}},
+		"unix epoch":    {Input: Date(1970, 1, 1), Expected: Hebrew{5730, Tevet, 23}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFromHebrew(t *testing.T) {
+	for d := Date(1900, 1, 1); d.Year() < 2100; d = d.AddDate(0, 0, 1) {
+		if got := FromHebrew(ToHebrew(d)); !got.Equal(d) {
+			t.Fatalf("round trip %v: got %v", d, got)
+		}
+	}
+	if got := FromHebrew(Hebrew{5784, AdarII, 14}); !got.Equal(Date(2024, 3, 24)) {
+		t.Errorf("purim got %v", got)
+	}
+}
+
+func TestHebrewMonthDays(t *testing.T) {
+	type input struct {
+		year  int
+		month HebrewMonth
+	}
+	fn := func(in input) (int, error) {
+		return HebrewMonthDays(in.year, in.month), nil
+	}
+
+	cases := trial.Cases[input, int]{
+		"adar I leap":      {Input: input{5784, Adar}, Expected: 30},
+		"adar":             {Input: input{5785, Adar}, Expected: 29},
+		"short marheshvan": {Input: input{5784, Marheshvan}, Expected: 29},
+		"long marheshvan":  {Input: input{5785, Marheshvan}, Expected: 30},
+		"nisan":            {Input: input{5785, Nisan}, Expected: 30},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if !IsHebrewLeapYear(5784) || IsHebrewLeapYear(5785) {
+		t.Error("5784 is a leap year and 5785 is not")
+	}
+}
+
+func TestHebrewHolidays(t *testing.T) {
+	fn := func(in HolidayFunc) ([]time.Time, error) {
+		return []time.Time{in(Date(2023, 1, 1)), in(Date(2024, 1, 1)), in(Date(2025, 1, 1))}, nil
+	}
+
+	cases := trial.Cases[HolidayFunc, []time.Time]{
+		"Rosh Hashanah": {Input: RoshHashanah, Expected: []time.Time{Date(2023, 9, 16), Date(2024, 10, 3), Date(2025, 9, 23)}},
+		"Yom Kippur":    {Input: YomKippur, Expected: []time.Time{Date(2023, 9, 25), Date(2024, 10, 12), Date(2025, 10, 2)}},
+		"Sukkot":        {Input: Sukkot, Expected: []time.Time{Date(2023, 9, 30), Date(2024, 10, 17), Date(2025, 10, 7)}},
+		"Hanukkah":      {Input: Hanukkah, Expected: []time.Time{Date(2023, 12, 8), Date(2024, 12, 26), Date(2025, 12, 15)}},
+		"Passover":      {Input: Passover, Expected: []time.Time{Date(2023, 4, 6), Date(2024, 4, 23), Date(2025, 4, 13)}},
+		"Shavuot":       {Input: Shavuot, Expected: []time.Time{Date(2023, 5, 26), Date(2024, 6, 12), Date(2025, 6, 2)}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestHebrewString(t *testing.T) {
+	if got := (Hebrew{5784, AdarII, 14}).String(); got != "14 Adar II 5784" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,179 @@
+package dates
+
+import (
+	"fmt"
+	"time"
+)
+
+// Hijri is a date in the Islamic (Hijri) calendar
+type Hijri struct {
+	Year  int
+	Month int // 1 (Muharram) to 12 (Dhu al-Hijjah)
+	Day   int
+}
+
+// HijriMonths are the transliterated names of the Hijri months
+var HijriMonths = [12]string{
+	"Muharram", "Safar", "Rabi al-Awwal", "Rabi al-Thani", "Jumada al-Ula", "Jumada al-Akhirah",
+	"Rajab", "Shaban", "Ramadan", "Shawwal", "Dhu al-Qadah", "Dhu al-Hijjah",
+}
+
+// String returns the date as YYYY-MM-DD
+func (h Hijri) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", h.Year, h.Month, h.Day)
+}
+
+// MonthName returns the name of the Hijri month, i.e., "Ramadan"
+func (h Hijri) MonthName() string {
+	if h.Month < 1 || h.Month > 12 {
+		return ""
+	}
+	return HijriMonths[h.Month-1]
+}
+
+// HijriCalendar converts between Gregorian and Hijri dates
+type HijriCalendar interface {
+	ToHijri(t time.Time) Hijri
+	FromHijri(h Hijri) time.Time
+}
+
+// TabularHijri is the arithmetic Islamic calendar with 30 year cycles of 11 leap years
+// (years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29) and the civil epoch of July 16th, 622.
+// Months start within a day or two of the observed calendar.
+var TabularHijri HijriCalendar = tabularHijri{}
+
+// hijriEpoch is the julian day number of 1 Muharram 1
+const hijriEpoch = 1948440
+
+type tabularHijri struct{}
+
+func (tabularHijri) toJDN(year, month, day int) int {
+	return day + (295*(month-1)+9)/10 + (year-1)*354 + floorDiv(3+11*year, 30) + hijriEpoch - 1
+}
+
+func (c tabularHijri) ToHijri(t time.Time) Hijri {
+	jd := JulianDayNumber(t)
+	year := floorDiv(30*(jd-hijriEpoch)+10646, 10631)
+	month := min(12, (10*(jd-c.toJDN(year, 1, 1))+295-1)/295+1)
+	for month > 1 && jd < c.toJDN(year, month, 1) {
+		month--
+	}
+	return Hijri{Year: year, Month: month, Day: jd - c.toJDN(year, month, 1) + 1}
+}
+
+func (c tabularHijri) FromHijri(h Hijri) time.Time {
+	return FromJulianDayNumber(c.toJDN(h.Year, h.Month, h.Day))
+}
+
+// HijriTable is a Hijri calendar of observed or official month lengths,
+// see UmmAlQura for the Umm al-Qura calendar of Saudi Arabia.
+// Dates outside of the table fall back to TabularHijri.
+type HijriTable struct {
+	Year         int       // Hijri year of the first month in the table
+	Start        time.Time // Gregorian date of 1 Muharram of Year
+	MonthLengths []int     // 29 or 30 days for each month starting with Muharram of Year
+}
+
+// ToHijri returns the Hijri date of t
+func (c HijriTable) ToHijri(t time.Time) Hijri {
+	days := JulianDayNumber(t) - JulianDayNumber(c.Start)
+	if days < 0 {
+		return TabularHijri.ToHijri(t)
+	}
+	for i, n := range c.MonthLengths {
+		if days < n {
+			return Hijri{Year: c.Year + i/12, Month: i%12 + 1, Day: days + 1}
+		}
+		days -= n
+	}
+	return TabularHijri.ToHijri(t)
+}
+
+// FromHijri returns the Gregorian date of h
+func (c HijriTable) FromHijri(h Hijri) time.Time {
+	idx := (h.Year-c.Year)*12 + h.Month - 1
+	if idx < 0 || idx >= len(c.MonthLengths) {
+		return TabularHijri.FromHijri(h)
+	}
+	days := h.Day - 1
+	for _, n := range c.MonthLengths[:idx] {
+		days += n
+	}
+	return Day(c.Start).AddDate(0, 0, days)
+}
+
+// ummAlQuraYears are the Umm al-Qura years 1420 to 1500 following the published tables of R.H. van Gent.
+// Bits 0 to 11 are the months Muharram to Dhu al-Hijjah with 30 days when set and 29 otherwise.
+var ummAlQuraYears = [...]uint16{
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92, // 1420
+	0xaa6, 0x956, 0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d, // 1430
+	0x2ba, 0x5b5, 0x5aa, 0xd55, 0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4, // 1440
+	0x6a5, 0x54b, 0xa97, 0x54e, 0xaae, 0x5ac, 0xba9, 0xd92, 0xb25, 0x64b, // 1450
+	0xcab, 0x55a, 0xb55, 0x6d2, 0xea5, 0xe4a, 0xa95, 0x52d, 0xaad, 0x36c, // 1460
+	0x759, 0x6d2, 0x695, 0x52d, 0xa5b, 0x4ba, 0x9ba, 0x3b4, 0xb69, 0xb52, // 1470
+	0xaa6, 0x4b6, 0x96d, 0x2ec, 0x6d9, 0xeb2, 0xd54, 0xd2a, 0xa56, 0x4ae, // 1480
+	0x96d, 0xd6a, 0xb54, 0xb29, 0xa93, 0x52b, 0xa57, 0x536, 0xab5, 0x6aa, // 1490
+	0xe93, // 1500
+}
+
+// UmmAlQura is the Umm al-Qura calendar of Saudi Arabia for 1420 to 1500 AH
+// (April 17th, 1999 to November 16th, 2077), dates outside of these years fall back to TabularHijri
+var UmmAlQura HijriCalendar = ummAlQura()
+
+func ummAlQura() HijriTable {
+	lengths := make([]int, 0, len(ummAlQuraYears)*12)
+	for _, info := range ummAlQuraYears {
+		for month := 0; month < 12; month++ {
+			lengths = append(lengths, 29+int(info>>month&1))
+		}
+	}
+	return HijriTable{Year: 1420, Start: Date(1999, time.April, 17), MonthLengths: lengths}
+}
+
+// ToHijri returns the tabular Hijri date of t
+func ToHijri(t time.Time) Hijri {
+	return TabularHijri.ToHijri(t)
+}
+
+// FromHijri returns the Gregorian date of the tabular Hijri date h
+func FromHijri(h Hijri) time.Time {
+	return TabularHijri.FromHijri(h)
+}
+
+// HijriHoliday returns a holiday on the Hijri month and day of the calendar.
+// The Hijri year is 11 days shorter so a holiday can fall twice in a Gregorian year,
+// the first one on or after date is returned, see HolidayFunc.
+func HijriHoliday(month, day int, cal HijriCalendar) HolidayFunc {
+	return func(date time.Time) time.Time {
+		date = Day(date)
+		year := cal.ToHijri(Date(date.Year(), time.January, 1)).Year
+		// up to 3 Hijri years overlap a Gregorian year
+		for y := year; y <= year+2; y++ {
+			t := cal.FromHijri(Hijri{Year: y, Month: month, Day: day})
+			if t.Year() == date.Year() && !t.Before(date) {
+				return t
+			}
+		}
+		return time.Time{}
+	}
+}
+
+// IslamicNewYear is 1 Muharram using the tabular Hijri calendar
+func IslamicNewYear(date time.Time) time.Time {
+	return HijriHoliday(1, 1, TabularHijri)(date)
+}
+
+// RamadanStart is 1 Ramadan using the tabular Hijri calendar
+func RamadanStart(date time.Time) time.Time {
+	return HijriHoliday(9, 1, TabularHijri)(date)
+}
+
+// EidAlFitr is 1 Shawwal using the tabular Hijri calendar
+func EidAlFitr(date time.Time) time.Time {
+	return HijriHoliday(10, 1, TabularHijri)(date)
+}
+
+// EidAlAdha is 10 Dhu al-Hijjah using the tabular Hijri calendar
+func EidAlAdha(date time.Time) time.Time {
+	return HijriHoliday(12, 10, TabularHijri)(date)
+}
@@ -0,0 +1,186 @@
+package dates
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestToHijri(t *testing.T) {
+	fn := func(in time.Time) (Hijri, error) {
+		return ToHijri(in), nil
+	}
+
+	cases := trial.Cases[time.Time, Hijri]{
+		"epoch":       {Input: time.Date(622, 7, 16, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 3), Expected: Hijri{1, 1, 1}},
+		"ramadan":     {Input: Date(2024, 3, 11), Expected: Hijri{1445, 9, 1}},
+		"end of year": {Input: Date(2024, 7, 7), Expected: Hijri{1445, 12, 30}},
+		"new year":    {Input: Date(2024, 7, 8), Expected: Hijri{1446, 1, 1}},
+		"with time":   {Input: time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), Expected: Hijri{1420, 9, 24}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestFromHijri(t *testing.T) {
+	fn := func(in Hijri) (time.Time, error) {
+		return FromHijri(in), nil
+	}
+
+	cases := trial.Cases[Hijri, time.Time]{
+		"ramadan":   {Input: Hijri{1445, 9, 1}, Expected: Date(2024, 3, 11)},
+		"eid":       {Input: Hijri{1446, 10, 1}, Expected: Date(2025, 3, 31)},
+		"leap year": {Input: Hijri{1445, 12, 30}, Expected: Date(2024, 7, 7)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	for d := Date(1900, 1, 1); d.Year() < 2100; d = d.AddDate(0, 0, 1) {
+		if got := FromHijri(ToHijri(d)); !got.Equal(d) {
+			t.Fatalf("round trip %v: got %v", d, got)
+		}
+	}
+}
+
+func TestHijriTable(t *testing.T) {
+	// 1445 as announced in Saudi Arabia, it starts a day before the tabular calendar
+	table := HijriTable{
+		Year:         1445,
+		Start:        Date(2023, 7, 19),
+		MonthLengths: []int{29, 30, 29, 30, 29, 30, 29, 30, 30, 29, 29, 30},
+	}
+	fn := func(in time.Time) (Hijri, error) {
+		return table.ToHijri(in), nil
+	}
+
+	cases := trial.Cases[time.Time, Hijri]{
+		"start":   {Input: Date(2023, 7, 19), Expected: Hijri{1445, 1, 1}},
+		"ramadan": {Input: Date(2024, 3, 11), Expected: Hijri{1445, 9, 1}},
+		"eid":     {Input: Date(2024, 4, 10), Expected: Hijri{1445, 10, 1}},
+		"before":  {Input: Date(2023, 7, 18), Expected: ToHijri(Date(2023, 7, 18))},
+		"after":   {Input: Date(2024, 7, 8), Expected: ToHijri(Date(2024, 7, 8))},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if got := table.FromHijri(Hijri{1445, 12, 10}); !got.Equal(Date(2024, 6, 16)) {
+		t.Errorf("eid al-adha got %v", got)
+	}
+	if got := HijriHoliday(12, 10, table)(Date(2024, 1, 1)); !got.Equal(Date(2024, 6, 16)) {
+		t.Errorf("holiday got %v", got)
+	}
+}
+
+func TestHijriHolidays(t *testing.T) {
+	fn := func(in HolidayFunc) ([]time.Time, error) {
+		return []time.Time{in(Date(2024, 1, 1)), in(Date(2025, 1, 1))}, nil
+	}
+
+	cases := trial.Cases[HolidayFunc, []time.Time]{
+		"Islamic New Year": {Input: IslamicNewYear, Expected: []time.Time{Date(2024, 7, 8), Date(2025, 6, 27)}},
+		"Ramadan":          {Input: RamadanStart, Expected: []time.Time{Date(2024, 3, 11), Date(2025, 3, 1)}},
+		"Eid al-Fitr":      {Input: EidAlFitr, Expected: []time.Time{Date(2024, 4, 10), Date(2025, 3, 31)}},
+		"Eid al-Adha":      {Input: EidAlAdha, Expected: []time.Time{Date(2024, 6, 17), Date(2025, 6, 7)}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestHijriHolidaysTwiceAYear(t *testing.T) {
+	cal := NewCalendar("hijri",
+		Holiday{"Islamic New Year", IslamicNewYear},
+		Holiday{"Eid al-Fitr", EidAlFitr},
+	)
+	fn := func(year int) ([]HolidayDate, error) {
+		return cal.HolidaysIn(year), nil
+	}
+
+	cases := trial.Cases[int, []HolidayDate]{
+		"2000": {Input: 2000, Expected: []HolidayDate{
+			{"Eid al-Fitr", Date(2000, 1, 8)},
+			{"Islamic New Year", Date(2000, 4, 6)},
+			{"Eid al-Fitr", Date(2000, 12, 28)},
+		}},
+		"2008": {Input: 2008, Expected: []HolidayDate{
+			{"Islamic New Year", Date(2008, 1, 10)},
+			{"Eid al-Fitr", Date(2008, 10, 2)},
+			{"Islamic New Year", Date(2008, 12, 29)},
+		}},
+		"2033": {Input: 2033, Expected: []HolidayDate{
+			{"Eid al-Fitr", Date(2033, 1, 3)},
+			{"Islamic New Year", Date(2033, 4, 1)},
+			{"Eid al-Fitr", Date(2033, 12, 23)},
+		}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	for _, d := range []time.Time{Date(2000, 12, 28), Date(2008, 12, 29), Date(2033, 12, 23)} {
+		if !cal.IsHoliday(d) {
+			t.Errorf("%s is not a holiday", d.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestShiftedHijriHolidays(t *testing.T) {
+	fn := func(in HolidayFunc) ([]time.Time, error) {
+		return Holiday{"Eid al-Fitr", in}.dates(2000), nil
+	}
+
+	// Eid al-Fitr is on Saturday, January 8th and Thursday, December 28th in 2000
+	cases := trial.Cases[HolidayFunc, []time.Time]{
+		"observed":        {Input: Observed(EidAlFitr), Expected: []time.Time{Date(2000, 1, 7), Date(2000, 12, 28)}},
+		"observed monday": {Input: ObservedMonday(EidAlFitr), Expected: []time.Time{Date(2000, 1, 10), Date(2000, 12, 28)}},
+		"day before":      {Input: offsetHoliday(EidAlFitr, -1), Expected: []time.Time{Date(2000, 1, 7), Date(2000, 12, 27)}},
+		"day after":       {Input: offsetHoliday(EidAlFitr, 1), Expected: []time.Time{Date(2000, 1, 9), Date(2000, 12, 29)}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	cal := NewCalendar("observed hijri", Holiday{"Eid al-Fitr", Observed(EidAlFitr)})
+	if !cal.IsHoliday(Date(2000, 12, 28)) {
+		t.Error("2000-12-28 is not a holiday")
+	}
+}
+
+func TestUmmAlQura(t *testing.T) {
+	fn := func(in time.Time) (Hijri, error) {
+		return UmmAlQura.ToHijri(in), nil
+	}
+
+	// dates announced by Saudi Arabia
+	cases := trial.Cases[time.Time, Hijri]{
+		"first day":        {Input: Date(1999, 4, 17), Expected: Hijri{1420, 1, 1}},
+		"eid al-fitr 1421": {Input: Date(2000, 12, 27), Expected: Hijri{1421, 10, 1}},
+		"ramadan 1444":     {Input: Date(2023, 3, 23), Expected: Hijri{1444, 9, 1}},
+		"eid al-fitr 1444": {Input: Date(2023, 4, 21), Expected: Hijri{1444, 10, 1}},
+		"eid al-adha 1445": {Input: Date(2024, 6, 16), Expected: Hijri{1445, 12, 10}},
+		"new year 1446":    {Input: Date(2024, 7, 7), Expected: Hijri{1446, 1, 1}},
+		"last day":         {Input: Date(2077, 11, 16), Expected: Hijri{1500, 12, 30}},
+		"before the table": {Input: Date(1999, 4, 16), Expected: ToHijri(Date(1999, 4, 16))},
+		"after the table":  {Input: Date(2077, 11, 17), Expected: ToHijri(Date(2077, 11, 17))},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	for d := Date(1999, 4, 17); d.Year() < 2078; d = d.AddDate(0, 0, 1) {
+		if got := UmmAlQura.FromHijri(UmmAlQura.ToHijri(d)); !got.Equal(d) {
+			t.Fatalf("%s round trips to %s", d.Format(time.DateOnly), got.Format(time.DateOnly))
+		}
+	}
+
+	cal := NewCalendar("umm al-qura", Holiday{"Eid al-Fitr", HijriHoliday(10, 1, UmmAlQura)})
+	want := []HolidayDate{{"Eid al-Fitr", Date(2000, 1, 8)}, {"Eid al-Fitr", Date(2000, 12, 27)}}
+	if got := cal.HolidaysIn(2000); !slices.Equal(got, want) {
+		t.Errorf("eid al-fitr 2000 got %v", got)
+	}
+}
+
+func TestHijriString(t *testing.T) {
+	h := Hijri{1445, 9, 1}
+	if h.String() != "1445-09-01" || h.MonthName() != "Ramadan" {
+		t.Errorf("got %s %s", h, h.MonthName())
+	}
+}
@@ -64,3 +64,137 @@ func LaborDay(date time.Time) time.Time {
 func VeteransDay(date time.Time) time.Time {
 	return Date(date.Year(), time.November, 11)
 }
+
+// Washington's Birthday (Presidents Day) the third Monday of February
+func WashingtonsBirthday(date time.Time) time.Time {
+	return nthWeekday(date.Year(), time.February, time.Monday, 3)
+}
+
+// Columbus Day the second Monday of October
+func ColumbusDay(date time.Time) time.Time {
+	return nthWeekday(date.Year(), time.October, time.Monday, 2)
+}
+
+// Thanksgiving Day the fourth Thursday of November
+func ThanksgivingDay(date time.Time) time.Time {
+	return nthWeekday(date.Year(), time.November, time.Thursday, 4)
+}
+
+func ChristmasEve(date time.Time) time.Time {
+	return Date(date.Year(), time.December, 24)
+}
+
+func ChristmasDay(date time.Time) time.Time {
+	return Date(date.Year(), time.December, 25)
+}
+
+// Easter is Easter Sunday of the Gregorian calendar (Anonymous Gregorian algorithm)
+func Easter(date time.Time) time.Time {
+	y := date.Year()
+	a, b, c := y%19, y/100, y%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+	return Date(y, time.Month(n/31), n%31+1)
+}
+
+// GoodFriday is the Friday before Easter
+func GoodFriday(date time.Time) time.Time {
+	return Easter(date).AddDate(0, 0, -2)
+}
+
+// nthWeekday returns the nth weekday wd of the month,
+// a negative n counts back from the end of the month i.e., -1 is the last weekday wd
+func nthWeekday(year int, month time.Month, wd time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := Date(year, month+1, 0)
+		last = last.Add(-OneDay * time.Duration((int(last.Weekday())-int(wd)+7)%7))
+		return WeekAdd(last, n+1)
+	}
+	first := Date(year, month, 1)
+	first = first.Add(OneDay * time.Duration((int(wd)-int(first.Weekday())+7)%7))
+	return WeekAdd(first, n-1)
+}
+
+// Observed returns the weekday a holiday is observed on, a holiday on Saturday
+// is observed the Friday before and a holiday on Sunday the Monday after
+func Observed(fn HolidayFunc) HolidayFunc {
+	return shiftHoliday(fn, observed)
+}
+
+// observed returns the weekday t is observed on, Friday for a Saturday and Monday for a Sunday
+func observed(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Saturday:
+		return t.Add(-OneDay)
+	case time.Sunday:
+		return t.Add(OneDay)
+	}
+	return t
+}
+
+// shiftHoliday returns the holiday with its dates moved by shift (a zero time drops the date).
+// The search for the first date on or after date skips the days that are moved before date,
+// so a holiday that falls twice in a year is found again from the day after the first observed day.
+// On January 1st every day of the year counts, even when it is moved into the year before.
+func shiftHoliday(fn HolidayFunc, shift func(time.Time) time.Time) HolidayFunc {
+	return func(date time.Time) time.Time {
+		date = Day(date)
+		d := fn(date)
+		for !d.IsZero() {
+			if t := shift(d); !t.IsZero() && (!t.Before(date) || date.YearDay() == 1) {
+				return t
+			}
+			from := Day(d).AddDate(0, 0, 1)
+			if from.Year() != date.Year() {
+				break
+			}
+			next := fn(from)
+			if !Day(next).After(Day(d)) {
+				break
+			}
+			d = next
+		}
+		return time.Time{}
+	}
+}
+
+// Since returns a holiday that only exists from the given year on,
+// i.e., Since(2021, Juneteenth)
+func Since(year int, fn HolidayFunc) HolidayFunc {
+	return func(date time.Time) time.Time {
+		if date.Year() < year {
+			return time.Time{}
+		}
+		return fn(date)
+	}
+}
+
+// Until returns a holiday that only exists up to and including the given year
+func Until(year int, fn HolidayFunc) HolidayFunc {
+	return func(date time.Time) time.Time {
+		if date.Year() > year {
+			return time.Time{}
+		}
+		return fn(date)
+	}
+}
+
+// ObservedMonday returns the day a holiday is observed when a holiday on
+// Saturday or Sunday is observed the Monday after (a substitute day)
+func ObservedMonday(fn HolidayFunc) HolidayFunc {
+	return shiftHoliday(fn, func(t time.Time) time.Time {
+		switch t.Weekday() {
+		case time.Saturday:
+			return t.AddDate(0, 0, 2)
+		case time.Sunday:
+			return t.AddDate(0, 0, 1)
+		}
+		return t
+	})
+}
@@ -126,3 +126,117 @@ func TestLaborDay(t *testing.T) {
 
 	trial.New(fn, cases).SubTest(t)
 }
+
+func TestThanksgivingDay(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return ThanksgivingDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"2024": {Input: Date(2024, 3, 20), Expected: Date(2024, 11, 28)},
+		"2023": {Input: Date(2023, 12, 20), Expected: Date(2023, 11, 23)},
+		"2025": {Input: Date(2025, 6, 20), Expected: Date(2025, 11, 27)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWashingtonsBirthday(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return WashingtonsBirthday(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"2024": {Input: Date(2024, 3, 20), Expected: Date(2024, 2, 19)},
+		"2025": {Input: Date(2025, 6, 20), Expected: Date(2025, 2, 17)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestColumbusDay(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return ColumbusDay(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"2024": {Input: Date(2024, 3, 20), Expected: Date(2024, 10, 14)},
+		"2025": {Input: Date(2025, 6, 20), Expected: Date(2025, 10, 13)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestObserved(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return Observed(IndependenceDay)(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"weekday":  {Input: Date(2024, 1, 1), Expected: Date(2024, 7, 4)},
+		"saturday": {Input: Date(2020, 1, 1), Expected: Date(2020, 7, 3)},
+		"sunday":   {Input: Date(2021, 1, 1), Expected: Date(2021, 7, 5)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestSince(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return Since(2021, Juneteenth)(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"before": {Input: Date(2020, 1, 1), Expected: time.Time{}},
+		"after":  {Input: Date(2021, 1, 1), Expected: Date(2021, 6, 19)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestEaster(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return Easter(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"2024":   {Input: Date(2024, 1, 1), Expected: Date(2024, 3, 31)},
+		"2025":   {Input: Date(2025, 1, 1), Expected: Date(2025, 4, 20)},
+		"1961":   {Input: Date(1961, 1, 1), Expected: Date(1961, 4, 2)},
+		"latest": {Input: Date(2038, 1, 1), Expected: Date(2038, 4, 25)},
+		"early":  {Input: Date(2285, 1, 1), Expected: Date(2285, 3, 22)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+
+	if got := GoodFriday(Date(2024, 1, 1)); !got.Equal(Date(2024, 3, 29)) {
+		t.Errorf("good friday got %v", got)
+	}
+}
+
+func TestUntil(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return Until(2020, ColumbusDay)(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"before": {Input: Date(2020, 1, 1), Expected: Date(2020, 10, 12)},
+		"after":  {Input: Date(2021, 1, 1), Expected: time.Time{}},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestObservedMonday(t *testing.T) {
+	fn := func(in time.Time) (time.Time, error) {
+		return ObservedMonday(ChristmasDay)(in), nil
+	}
+
+	cases := trial.Cases[time.Time, time.Time]{
+		"weekday":  {Input: Date(2024, 1, 1), Expected: Date(2024, 12, 25)},
+		"saturday": {Input: Date(2021, 1, 1), Expected: Date(2021, 12, 27)},
+		"sunday":   {Input: Date(2022, 1, 1), Expected: Date(2022, 12, 26)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
@@ -0,0 +1,228 @@
+// Package httpapi serves the period, holiday and business day calculations of the dates package
+// as JSON over HTTP so other languages share the same semantics.
+//
+//	GET /range?period=pymtd&asof=2024-02-15&week=sun-sat
+//	GET /holidays?year=2025&calendar=us
+//	GET /bizdays?start=2024-01-01&end=2024-03-31&calendar=us
+//	GET /calendars
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	dates "github.com/hydronica/godates"
+)
+
+// Range is the JSON response of the /range endpoint
+type Range struct {
+	Period string `json:"period"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Days   int    `json:"days"`
+	Label  string `json:"label,omitempty"`
+}
+
+// Holiday is a JSON item of the /holidays response
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// BusinessDays is the JSON response of the /bizdays endpoint
+type BusinessDays struct {
+	Start        string `json:"start"`
+	End          string `json:"end"`
+	Calendar     string `json:"calendar"`
+	BusinessDays int    `json:"business_days"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// errBadRequest marks errors caused by invalid query parameters
+var errBadRequest = errors.New("bad request")
+
+const (
+	minYear = 1583 // the first full year of the Gregorian calendar
+	maxYear = 9999 // the last year of a YYYY-MM-DD date
+
+	// maxBizdays is the longest /bizdays span in days, about 100 years
+	maxBizdays = 36525
+)
+
+// checkYear returns a bad request error when the year of t is outside minYear to maxYear
+func checkYear(t time.Time, name string) error {
+	if t.Year() < minYear || t.Year() > maxYear {
+		return fmt.Errorf("%w: %s year %d is outside %d to %d", errBadRequest, name, t.Year(), minYear, maxYear)
+	}
+	return nil
+}
+
+// NewHandler returns the http.Handler of the date endpoints,
+// dates default to today (UTC), weeks to MON-SUN and calendars to "us"
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/range", get(handleRange))
+	mux.HandleFunc("/holidays", get(handleHolidays))
+	mux.HandleFunc("/bizdays", get(handleBizdays))
+	mux.HandleFunc("/calendars", get(func(*http.Request) (any, error) {
+		return dates.CalendarNames(), nil
+	}))
+	return mux
+}
+
+// get wraps fn to only allow GET requests and write the response or error as JSON
+func get(fn func(r *http.Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+		v, err := fn(r)
+		switch {
+		case errors.Is(err, errBadRequest), errors.Is(err, dates.ErrUnknownPeriod):
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		case err != nil:
+			writeJSON(w, http.StatusInternalServerError, errorResponse{Error: err.Error()})
+		default:
+			writeJSON(w, http.StatusOK, v)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func handleRange(r *http.Request) (any, error) {
+	q := r.URL.Query()
+	period := q.Get("period")
+	if period == "" {
+		return nil, fmt.Errorf("%w: period is required", errBadRequest)
+	}
+	asof, err := dateParam(q.Get("asof"), "asof")
+	if err != nil {
+		return nil, err
+	}
+	week, err := weekParam(q.Get("week"))
+	if err != nil {
+		return nil, err
+	}
+
+	rng, err := week.ParsePeriod(period, asof)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkYear(rng.Start, "period"); err != nil {
+		return nil, err
+	}
+	if err := checkYear(rng.End, "period"); err != nil {
+		return nil, err
+	}
+	label, _ := week.PeriodLabel(rng)
+	return Range{
+		Period: period,
+		Start:  rng.Start.Format(time.DateOnly),
+		End:    rng.End.Format(time.DateOnly),
+		Days:   rng.Days(),
+		Label:  label,
+	}, nil
+}
+
+func handleHolidays(r *http.Request) (any, error) {
+	q := r.URL.Query()
+	year := time.Now().UTC().Year()
+	if s := q.Get("year"); s != "" {
+		var err error
+		if year, err = strconv.Atoi(s); err != nil {
+			return nil, fmt.Errorf("%w: invalid year %q", errBadRequest, s)
+		}
+		if year < minYear || year > maxYear {
+			return nil, fmt.Errorf("%w: year %d is outside %d to %d", errBadRequest, year, minYear, maxYear)
+		}
+	}
+	cal, err := calendarParam(q.Get("calendar"))
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make([]Holiday, 0)
+	for _, h := range cal.HolidaysIn(year) {
+		holidays = append(holidays, Holiday{Date: h.Date.Format(time.DateOnly), Name: h.Name})
+	}
+	return holidays, nil
+}
+
+func handleBizdays(r *http.Request) (any, error) {
+	q := r.URL.Query()
+	if q.Get("start") == "" || q.Get("end") == "" {
+		return nil, fmt.Errorf("%w: start and end are required", errBadRequest)
+	}
+	start, err := dateParam(q.Get("start"), "start")
+	if err != nil {
+		return nil, err
+	}
+	end, err := dateParam(q.Get("end"), "end")
+	if err != nil {
+		return nil, err
+	}
+	if days := dates.DaysBetween(start, end); days > maxBizdays || days < -maxBizdays {
+		return nil, fmt.Errorf("%w: start and end are more than %d days apart", errBadRequest, maxBizdays)
+	}
+	cal, err := calendarParam(q.Get("calendar"))
+	if err != nil {
+		return nil, err
+	}
+
+	return BusinessDays{
+		Start:        start.Format(time.DateOnly),
+		End:          end.Format(time.DateOnly),
+		Calendar:     cal.Name,
+		BusinessDays: cal.BusinessDays(dates.NewRange(start, end)),
+	}, nil
+}
+
+// dateParam parses a YYYY-MM-DD query value from minYear on, today is used when empty
+func dateParam(s, name string) (time.Time, error) {
+	if s == "" {
+		return dates.Day(time.Now().UTC()), nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return t, fmt.Errorf("%w: invalid %s date %q", errBadRequest, name, s)
+	}
+	return t, checkYear(t, name)
+}
+
+// weekParam parses a week like MON-SUN, MON-SUN is used when empty
+func weekParam(s string) (dates.Week, error) {
+	if s == "" {
+		s = "mon-sun"
+	}
+	w, err := dates.ParseWeek(s)
+	if err != nil {
+		return w, fmt.Errorf("%w: %v", errBadRequest, err)
+	}
+	return w, nil
+}
+
+// calendarParam looks up a registered calendar, "us" is used when empty
+func calendarParam(s string) (dates.Calendar, error) {
+	if s == "" {
+		s = "us"
+	}
+	cal, ok := dates.LookupCalendar(s)
+	if !ok {
+		return cal, fmt.Errorf("%w: unknown calendar %q", errBadRequest, s)
+	}
+	return cal, nil
+}
@@ -0,0 +1,106 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hydronica/trial"
+)
+
+func TestHandler(t *testing.T) {
+	h := NewHandler()
+	type response struct {
+		status int
+		body   string
+	}
+	type input struct {
+		method string
+		url    string
+	}
+	fn := func(in input) (response, error) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(in.method, in.url, nil))
+		return response{status: w.Code, body: strings.TrimSpace(w.Body.String())}, nil
+	}
+
+	cases := trial.Cases[input, response]{
+		"prev year month to date": {
+			Input: input{http.MethodGet, "/range?period=pymtd&asof=2024-02-15"},
+			Expected: response{http.StatusOK,
+				`{"period":"pymtd","start":"2023-02-01","end":"2023-02-15","days":15}`},
+		},
+		"last full week sunday start": {
+			Input: input{http.MethodGet, "/range?period=lfw&asof=2024-02-05&week=sun-sat"},
+			Expected: response{http.StatusOK,
+				`{"period":"lfw","start":"2024-01-28","end":"2024-02-03","days":7,"label":"Week 5, 2024"}`},
+		},
+		"phrase": {
+			Input: input{http.MethodGet, "/range?period=previous+quarter&asof=2024-02-15"},
+			Expected: response{http.StatusOK,
+				`{"period":"previous quarter","start":"2023-10-01","end":"2023-12-31","days":92,"label":"Q4 2023"}`},
+		},
+		"unknown period": {
+			Input:    input{http.MethodGet, "/range?period=fortnight&asof=2024-02-15"},
+			Expected: response{http.StatusBadRequest, `{"error":"unknown period: \"fortnight\""}`},
+		},
+		"missing period": {
+			Input:    input{http.MethodGet, "/range"},
+			Expected: response{http.StatusBadRequest, `{"error":"bad request: period is required"}`},
+		},
+		"invalid week": {
+			Input: input{http.MethodGet, "/range?period=lfw&week=mon-fri"},
+			Expected: response{http.StatusBadRequest,
+				`{"error":"bad request: invalid week \"mon-fri\": start and end days are not consecutive"}`},
+		},
+		"holidays": {
+			Input: input{http.MethodGet, "/holidays?year=2024&calendar=us"},
+			Expected: response{http.StatusOK,
+				`[{"date":"2024-01-01","name":"New Year's Day"},` +
+					`{"date":"2024-01-15","name":"Martin Luther King Jr. Day"},` +
+					`{"date":"2024-02-19","name":"Washington's Birthday"},` +
+					`{"date":"2024-05-27","name":"Memorial Day"},` +
+					`{"date":"2024-06-19","name":"Juneteenth"},` +
+					`{"date":"2024-07-04","name":"Independence Day"},` +
+					`{"date":"2024-09-02","name":"Labor Day"},` +
+					`{"date":"2024-10-14","name":"Columbus Day"},` +
+					`{"date":"2024-11-11","name":"Veterans Day"},` +
+					`{"date":"2024-11-28","name":"Thanksgiving Day"},` +
+					`{"date":"2024-12-25","name":"Christmas Day"}]`},
+		},
+		"unknown calendar": {
+			Input:    input{http.MethodGet, "/holidays?year=2024&calendar=mars"},
+			Expected: response{http.StatusBadRequest, `{"error":"bad request: unknown calendar \"mars\""}`},
+		},
+		"bizdays": {
+			Input: input{http.MethodGet, "/bizdays?start=2024-01-01&end=2024-03-31"},
+			Expected: response{http.StatusOK,
+				`{"start":"2024-01-01","end":"2024-03-31","calendar":"us","business_days":62}`},
+		},
+		"period out of range": {
+			Input: input{http.MethodGet, "/range?period=in+900000+years&asof=2025-01-01"},
+			Expected: response{http.StatusBadRequest,
+				`{"error":"bad request: period year 902025 is outside 1583 to 9999"}`},
+		},
+		"year out of range": {
+			Input:    input{http.MethodGet, "/holidays?year=99999"},
+			Expected: response{http.StatusBadRequest, `{"error":"bad request: year 99999 is outside 1583 to 9999"}`},
+		},
+		"bizdays span too long": {
+			Input: input{http.MethodGet, "/bizdays?start=1900-01-01&end=9999-12-31"},
+			Expected: response{http.StatusBadRequest,
+				`{"error":"bad request: start and end are more than 36525 days apart"}`},
+		},
+		"bizdays date out of range": {
+			Input:    input{http.MethodGet, "/bizdays?start=0001-01-01&end=0001-12-31"},
+			Expected: response{http.StatusBadRequest, `{"error":"bad request: start year 1 is outside 1583 to 9999"}`},
+		},
+		"method not allowed": {
+			Input:    input{http.MethodPost, "/range?period=lfw"},
+			Expected: response{http.StatusMethodNotAllowed, `{"error":"method not allowed"}`},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
@@ -0,0 +1,305 @@
+package dates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NameWidth is the width of a month or weekday name
+type NameWidth int
+
+const (
+	Wide        NameWidth = iota // January, Monday
+	Abbreviated                  // Jan, Mon
+	Narrow                       // J, M
+)
+
+// Locale has the month and weekday names of a language
+// used to format and parse dates and ranges.
+// Weekdays are ordered the same as time.Weekday (starting Sunday).
+type Locale struct {
+	Tag string // language tag i.e., "fr"
+
+	Months       [12]string
+	MonthsAbbr   [12]string
+	MonthsNarrow [12]string
+
+	Weekdays       [7]string
+	WeekdaysAbbr   [7]string
+	WeekdaysNarrow [7]string
+
+	ranges       rangeLayouts
+	monthLabel   string // time layout of a month label
+	quarterLabel string // fmt format of a quarter label, quarter then year
+	weekLabel    string // fmt format of a week label, week then year
+}
+
+// English is the default locale, the names match the time package
+var English = Locale{
+	Tag:            "en",
+	Months:         [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	MonthsAbbr:     [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	MonthsNarrow:   [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	Weekdays:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	WeekdaysAbbr:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	WeekdaysNarrow: [7]string{"S", "M", "T", "W", "T", "F", "S"},
+	ranges: rangeLayouts{
+		date:      "Jan 2, 2006",
+		sameMonth: [2]string{"Jan 2", "2, 2006"},
+		sameYear:  [2]string{"Jan 2", "Jan 2, 2006"},
+		numeric:   "1/2/2006",
+		sep:       " – ",
+		daySep:    "–",
+	},
+	monthLabel:   "January 2006",
+	quarterLabel: "Q%d %d",
+	weekLabel:    "Week %d, %d",
+}
+
+// French month and weekday names
+var French = Locale{
+	Tag:            "fr",
+	Months:         [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	MonthsAbbr:     [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	MonthsNarrow:   [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	Weekdays:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	WeekdaysAbbr:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	WeekdaysNarrow: [7]string{"D", "L", "M", "M", "J", "V", "S"},
+	ranges: rangeLayouts{
+		date:      "2 Jan 2006",
+		sameMonth: [2]string{"2", "2 Jan 2006"},
+		sameYear:  [2]string{"2 Jan", "2 Jan 2006"},
+		numeric:   "02/01/2006",
+		sep:       " – ",
+		daySep:    "–",
+	},
+	monthLabel:   "January 2006",
+	quarterLabel: "T%d %d",
+	weekLabel:    "Semaine %d, %d",
+}
+
+// German month and weekday names
+var German = Locale{
+	Tag:            "de",
+	Months:         [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	MonthsAbbr:     [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+	MonthsNarrow:   [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	Weekdays:       [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	WeekdaysAbbr:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	WeekdaysNarrow: [7]string{"S", "M", "D", "M", "D", "F", "S"},
+	ranges: rangeLayouts{
+		date:      "2. Jan 2006",
+		sameMonth: [2]string{"2.", "2. Jan 2006"},
+		sameYear:  [2]string{"2. Jan", "2. Jan 2006"},
+		numeric:   "02.01.2006",
+		sep:       " – ",
+		daySep:    "–",
+	},
+	monthLabel:   "January 2006",
+	quarterLabel: "Q%d %d",
+	weekLabel:    "KW %d, %d",
+}
+
+// Spanish month and weekday names
+var Spanish = Locale{
+	Tag:            "es",
+	Months:         [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	MonthsAbbr:     [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	MonthsNarrow:   [12]string{"E", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	Weekdays:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	WeekdaysAbbr:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	WeekdaysNarrow: [7]string{"D", "L", "M", "X", "J", "V", "S"},
+	ranges: rangeLayouts{
+		date:      "2 Jan 2006",
+		sameMonth: [2]string{"2", "2 Jan 2006"},
+		sameYear:  [2]string{"2 Jan", "2 Jan 2006"},
+		numeric:   "02/01/2006",
+		sep:       " – ",
+		daySep:    "–",
+	},
+	monthLabel:   "January 2006",
+	quarterLabel: "T%d %d",
+	weekLabel:    "Semana %d, %d",
+}
+
+// Japanese month and weekday names
+var Japanese = Locale{
+	Tag:            "ja",
+	Months:         [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	MonthsAbbr:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	MonthsNarrow:   [12]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+	Weekdays:       [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	WeekdaysAbbr:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	WeekdaysNarrow: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	ranges: rangeLayouts{
+		date:      "2006年1月2日",
+		sameMonth: [2]string{"2006年1月2日", "2日"},
+		sameYear:  [2]string{"2006年1月2日", "1月2日"},
+		numeric:   "2006/01/02",
+		sep:       "～",
+		daySep:    "～",
+	},
+	monthLabel:   "2006年1月",
+	quarterLabel: "%[2]d年 第%[1]d四半期",
+	weekLabel:    "%[2]d年 第%[1]d週",
+}
+
+var locales = map[string]Locale{
+	English.Tag:  English,
+	French.Tag:   French,
+	German.Tag:   German,
+	Spanish.Tag:  Spanish,
+	Japanese.Tag: Japanese,
+}
+
+// LookupLocale returns the locale for a language tag like "fr", "fr-CA" or "de_DE",
+// only the language part of the tag is used
+func LookupLocale(tag string) (Locale, bool) {
+	lang, _, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(tag, "_", "-")), "-")
+	l, ok := locales[lang]
+	return l, ok
+}
+
+// MonthName returns the name of month m
+func (l Locale) MonthName(m time.Month, width NameWidth) string {
+	switch width {
+	case Abbreviated:
+		return l.MonthsAbbr[m-1]
+	case Narrow:
+		return l.MonthsNarrow[m-1]
+	}
+	return l.Months[m-1]
+}
+
+// WeekdayName returns the name of weekday wd
+func (l Locale) WeekdayName(wd time.Weekday, width NameWidth) string {
+	switch width {
+	case Abbreviated:
+		return l.WeekdaysAbbr[wd]
+	case Narrow:
+		return l.WeekdaysNarrow[wd]
+	}
+	return l.Weekdays[wd]
+}
+
+// nameTokens are the layout elements of time.Format that are written as names, longest first
+var nameTokens = []string{"January", "Monday", "Jan", "Mon"}
+
+// splitLayout splits a time layout into name tokens and everything else
+func splitLayout(layout string) []string {
+	var chunks []string
+	last := 0
+	for i := 0; i < len(layout); i++ {
+		for _, tok := range nameTokens {
+			if !strings.HasPrefix(layout[i:], tok) {
+				continue
+			}
+			if last < i {
+				chunks = append(chunks, layout[last:i])
+			}
+			chunks = append(chunks, tok)
+			i += len(tok) - 1
+			last = i + 1
+			break
+		}
+	}
+	if last < len(layout) {
+		chunks = append(chunks, layout[last:])
+	}
+	return chunks
+}
+
+// Format returns t formatted with a time layout (see time.Format)
+// with the month and weekday names of the locale
+func (l Locale) Format(t time.Time, layout string) string {
+	var b strings.Builder
+	for _, chunk := range splitLayout(layout) {
+		switch chunk {
+		case "January":
+			b.WriteString(l.MonthName(t.Month(), Wide))
+		case "Jan":
+			b.WriteString(l.MonthName(t.Month(), Abbreviated))
+		case "Monday":
+			b.WriteString(l.WeekdayName(t.Weekday(), Wide))
+		case "Mon":
+			b.WriteString(l.WeekdayName(t.Weekday(), Abbreviated))
+		default:
+			b.WriteString(t.Format(chunk))
+		}
+	}
+	return b.String()
+}
+
+// Parse parses a date written with the month and weekday names
+// of the locale using a time layout (see time.Parse). Names are matched without case.
+func (l Locale) Parse(layout, value string) (time.Time, error) {
+	cursor := 0
+	for _, chunk := range splitLayout(layout) {
+		var names, english []string
+		switch chunk {
+		case "January":
+			names, english = l.Months[:], English.Months[:]
+		case "Jan":
+			names, english = l.MonthsAbbr[:], English.MonthsAbbr[:]
+		case "Monday":
+			names, english = l.Weekdays[:], English.Weekdays[:]
+		case "Mon":
+			names, english = l.WeekdaysAbbr[:], English.WeekdaysAbbr[:]
+		default:
+			continue
+		}
+		pos, idx := findName(value, cursor, names)
+		if idx < 0 {
+			return time.Time{}, fmt.Errorf("parsing %q as %q: no %s name found for locale %q", value, layout, chunk, l.Tag)
+		}
+		value = value[:pos] + english[idx] + value[pos+len(names[idx]):]
+		cursor = pos + len(english[idx])
+	}
+	return time.Parse(layout, value)
+}
+
+// findName returns the byte position and index of the first name found in s
+// starting at from, the longest name is used when several match at the same position
+func findName(s string, from int, names []string) (pos, idx int) {
+	for pos = from; pos < len(s); pos++ {
+		idx = -1
+		for i, name := range names {
+			if len(s)-pos < len(name) || !strings.EqualFold(s[pos:pos+len(name)], name) {
+				continue
+			}
+			if idx < 0 || len(name) > len(names[idx]) {
+				idx = i
+			}
+		}
+		if idx >= 0 {
+			return pos, idx
+		}
+	}
+	return -1, -1
+}
+
+// FormatRange returns the range as text in the locale, see FormatRange
+func (l Locale) FormatRange(r Range, style RangeStyle) string {
+	return l.ranges.format(r, style, l.Format)
+}
+
+// PeriodLabel returns the label in the locale of a range that is exactly
+// a week, month, quarter or year of the given week definition, see Week.PeriodLabel
+func (l Locale) PeriodLabel(d Week, r Range) (string, bool) {
+	start, end := Day(r.Start), Day(r.End)
+	period := NewRange(start, end)
+
+	switch {
+	case period.Equal(NewRange(FullYear(start))):
+		return fmt.Sprintf("%d", start.Year()), true
+	case period.Equal(NewRange(FullQuarter(start))):
+		return fmt.Sprintf(l.quarterLabel, Quarter(start), start.Year()), true
+	case period.Equal(NewRange(FullMonth(start))):
+		return l.Format(start, l.monthLabel), true
+	case start.Equal(d.StartOfWeek(start)) && period.Days() == 7:
+		year, week := d.WeekNumber(start)
+		return fmt.Sprintf(l.weekLabel, week, year), true
+	}
+	return "", false
+}
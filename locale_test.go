@@ -0,0 +1,175 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestLookupLocale(t *testing.T) {
+	fn := func(in string) (string, error) {
+		l, ok := LookupLocale(in)
+		if !ok {
+			return "", nil
+		}
+		return l.Tag, nil
+	}
+
+	cases := trial.Cases[string, string]{
+		"language":   {Input: "fr", Expected: "fr"},
+		"region":     {Input: "de-AT", Expected: "de"},
+		"underscore": {Input: "es_MX", Expected: "es"},
+		"case":       {Input: "JA-jp", Expected: "ja"},
+		"unknown":    {Input: "xx", Expected: ""},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestLocaleFormat(t *testing.T) {
+	type input struct {
+		locale Locale
+		layout string
+	}
+	date := time.Date(2024, 2, 6, 15, 4, 0, 0, time.UTC) // Tuesday
+	fn := func(in input) (string, error) {
+		return in.locale.Format(date, in.layout), nil
+	}
+
+	cases := trial.Cases[input, string]{
+		"english": {
+			Input:    input{English, "Monday, January 2 2006 15:04"},
+			Expected: "Tuesday, February 6 2024 15:04",
+		},
+		"french": {
+			Input:    input{French, "Monday 2 January 2006"},
+			Expected: "mardi 6 février 2024",
+		},
+		"french abbreviated": {
+			Input:    input{French, "Mon 2 Jan 2006"},
+			Expected: "mar. 6 févr. 2024",
+		},
+		"german": {
+			Input:    input{German, "Monday, 2. January 2006"},
+			Expected: "Dienstag, 6. Februar 2024",
+		},
+		"spanish": {
+			Input:    input{Spanish, "Monday 2 Jan 2006"},
+			Expected: "martes 6 feb 2024",
+		},
+		"japanese": {
+			Input:    input{Japanese, "2006年1月2日 (Mon)"},
+			Expected: "2024年2月6日 (火)",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestLocaleParse(t *testing.T) {
+	type input struct {
+		locale Locale
+		layout string
+		value  string
+	}
+	fn := func(in input) (time.Time, error) {
+		return in.locale.Parse(in.layout, in.value)
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"french": {
+			Input:    input{French, "Monday 2 January 2006", "mardi 6 février 2024"},
+			Expected: Date(2024, 2, 6),
+		},
+		"german abbreviated": {
+			Input:    input{German, "2. Jan 2006", "6. Feb. 2024"},
+			Expected: Date(2024, 2, 6),
+		},
+		"spanish weekday and month share a name": {
+			Input:    input{Spanish, "Mon 2 Jan 2006", "mar 5 mar 2024"},
+			Expected: Date(2024, 3, 5),
+		},
+		"case insensitive": {
+			Input:    input{Spanish, "2 January 2006", "5 Marzo 2024"},
+			Expected: Date(2024, 3, 5),
+		},
+		"japanese weekday": {
+			Input:    input{Japanese, "2006/01/02 Monday", "2024/02/06 火曜日"},
+			Expected: Date(2024, 2, 6),
+		},
+		"missing name": {
+			Input:     input{French, "2 January 2006", "6 February 2024"},
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestLocaleFormatRange(t *testing.T) {
+	type input struct {
+		locale Locale
+		r      Range
+		style  RangeStyle
+	}
+	fn := func(in input) (string, error) {
+		return in.locale.FormatRange(in.r, in.style), nil
+	}
+
+	feb := NewRange(FullMonth(Date(2024, 2, 1)))
+	lfw := NewRange(Date(2024, 1, 29), Date(2024, 2, 4))
+	cases := trial.Cases[input, string]{
+		"french same month": {
+			Input:    input{French, feb, ShortStyle},
+			Expected: "1–29 févr. 2024",
+		},
+		"french long": {
+			Input:    input{French, lfw, LongStyle},
+			Expected: "29 janvier – 4 février 2024",
+		},
+		"german": {
+			Input:    input{German, lfw, ShortStyle},
+			Expected: "29. Jan. – 4. Feb. 2024",
+		},
+		"german numeric": {
+			Input:    input{German, lfw, NumericStyle},
+			Expected: "29.01.2024 – 04.02.2024",
+		},
+		"japanese": {
+			Input:    input{Japanese, feb, ShortStyle},
+			Expected: "2024年2月1日～29日",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestLocalePeriodLabel(t *testing.T) {
+	d := NewWeek(time.Monday, time.Sunday)
+	type input struct {
+		locale Locale
+		r      Range
+	}
+	fn := func(in input) (string, error) {
+		l, _ := in.locale.PeriodLabel(d, in.r)
+		return l, nil
+	}
+
+	cases := trial.Cases[input, string]{
+		"french month": {
+			Input:    input{French, NewRange(FullMonth(Date(2024, 2, 1)))},
+			Expected: "février 2024",
+		},
+		"spanish quarter": {
+			Input:    input{Spanish, NewRange(FullQuarter(Date(2024, 2, 1)))},
+			Expected: "T1 2024",
+		},
+		"japanese week": {
+			Input:    input{Japanese, NewRange(Date(2024, 1, 29), Date(2024, 2, 4))},
+			Expected: "2024年 第5週",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
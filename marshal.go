@@ -0,0 +1,230 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateOnly is a date without a time of day that is stored and marshaled as YYYY-MM-DD
+type DateOnly struct {
+	time.Time
+}
+
+// NewDateOnly returns the DateOnly of the day of t
+func NewDateOnly(t time.Time) DateOnly {
+	return DateOnly{Day(t)}
+}
+
+// String returns the date as YYYY-MM-DD
+func (d DateOnly) String() string {
+	return d.Format(time.DateOnly)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d DateOnly) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, an empty value is the zero date
+func (d *DateOnly) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*d = DateOnly{}
+		return nil
+	}
+	t, err := parseDate(string(b))
+	if err != nil {
+		return err
+	}
+	*d = DateOnly{t}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the zero date is null
+func (d DateOnly) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *DateOnly) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*d = DateOnly{}
+		return nil
+	}
+	return d.UnmarshalText([]byte(strings.Trim(string(b), `"`)))
+}
+
+// Value implements driver.Valuer, the zero date is NULL
+func (d DateOnly) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.Time, nil
+}
+
+// Scan implements sql.Scanner for time, string and []byte values
+func (d *DateOnly) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*d = DateOnly{}
+	case time.Time:
+		*d = DateOnly{Date(v.Year(), v.Month(), v.Day())}
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("can not scan %T into DateOnly", src)
+	}
+	return nil
+}
+
+// parseDate parses YYYY-MM-DD or an RFC 3339 time truncated to the day
+func parseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return t, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", s)
+	}
+	return Date(t.Year(), t.Month(), t.Day()), nil
+}
+
+// text returns the week as "MON-SUN", the zero Week is written as the default week
+// so it can be parsed again
+func (d Week) text() string {
+	if d == (Week{}) {
+		return defaultWeek.String()
+	}
+	return d.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, the week is written as "MON-SUN"
+func (d Week) MarshalText() ([]byte, error) {
+	return []byte(d.text()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, see ParseWeek
+func (d *Week) UnmarshalText(b []byte) error {
+	w, err := ParseWeek(string(b))
+	if err != nil {
+		return err
+	}
+	*d = w
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the week is a string like "MON-SUN"
+func (d Week) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.text() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Week) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	return d.UnmarshalText([]byte(strings.Trim(string(b), `"`)))
+}
+
+// Value implements driver.Valuer, the week is stored as text like "MON-SUN"
+func (d Week) Value() (driver.Value, error) {
+	return d.text(), nil
+}
+
+// Scan implements sql.Scanner for string and []byte values
+func (d *Week) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	}
+	return fmt.Errorf("can not scan %T into Week", src)
+}
+
+// ParseRange parses an ISO 8601 interval of dates like "2024-01-01/2024-01-31"
+// or a PostgreSQL range literal like "[2024-01-01,2024-02-01)", see ParsePgRange
+func ParseRange(s string) (Range, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") || strings.HasPrefix(s, "(") || strings.EqualFold(s, "empty") {
+		p, err := ParsePgRange(s)
+		if err != nil {
+			return Range{}, err
+		}
+		return p.Range()
+	}
+	first, last, ok := strings.Cut(s, "/")
+	if !ok {
+		return Range{}, fmt.Errorf("invalid range %q: expected start/end", s)
+	}
+	start, err := parseDate(first)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	end, err := parseDate(last)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	return NewRange(start, end), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, the range is written as an ISO 8601 interval
+func (r Range) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, see ParseRange
+func (r *Range) UnmarshalText(b []byte) error {
+	v, err := ParseRange(string(b))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the range is a string of an ISO 8601 interval
+func (r Range) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (r *Range) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	return r.UnmarshalText([]byte(strings.Trim(string(b), `"`)))
+}
+
+// Value implements driver.Valuer, the range is stored as a Postgres daterange literal
+// in its canonical half open form, i.e., "[2024-01-01,2024-02-01)"
+func (r Range) Value() (driver.Value, error) {
+	if r.Start.IsZero() && r.End.IsZero() {
+		return nil, nil
+	}
+	return r.PgRange().String(), nil
+}
+
+// Scan implements sql.Scanner for daterange literals and ISO 8601 intervals
+func (r *Range) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*r = Range{}
+		return nil
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case []byte:
+		return r.UnmarshalText(v)
+	}
+	return fmt.Errorf("can not scan %T into Range", src)
+}
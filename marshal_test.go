@@ -0,0 +1,195 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+type config struct {
+	Week   Week     `json:"week"`
+	Period Range    `json:"period"`
+	AsOf   DateOnly `json:"asof"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	fn := func(in config) (string, error) {
+		b, err := json.Marshal(in)
+		return string(b), err
+	}
+
+	cases := trial.Cases[config, string]{
+		"config": {
+			Input: config{
+				Week:   NewWeek(time.Sunday, time.Saturday),
+				Period: NewRange(FullMonth(Date(2024, 2, 1))),
+				AsOf:   NewDateOnly(time.Date(2024, 2, 5, 10, 0, 0, 0, time.UTC)),
+			},
+			Expected: `{"week":"SUN-SAT","period":"2024-02-01/2024-02-29","asof":"2024-02-05"}`,
+		},
+		"zero date": {
+			Input:    config{Week: NewWeek(time.Monday, time.Sunday), Period: NewRange(Date(2024, 2, 1), Date(2024, 2, 1))},
+			Expected: `{"week":"MON-SUN","period":"2024-02-01/2024-02-01","asof":null}`,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	fn := func(in string) (config, error) {
+		var c config
+		err := json.Unmarshal([]byte(in), &c)
+		return c, err
+	}
+
+	cases := trial.Cases[string, config]{
+		"config": {
+			Input: `{"week":"sun-sat","period":"2024-02-01/2024-02-29","asof":"2024-02-05"}`,
+			Expected: config{
+				Week:   NewWeek(time.Sunday, time.Saturday),
+				Period: NewRange(FullMonth(Date(2024, 2, 1))),
+				AsOf:   NewDateOnly(Date(2024, 2, 5)),
+			},
+		},
+		"daterange": {
+			Input: `{"week":"MON-SUN","period":"[2024-02-01,2024-03-01)","asof":null}`,
+			Expected: config{
+				Week:   NewWeek(time.Monday, time.Sunday),
+				Period: NewRange(FullMonth(Date(2024, 2, 1))),
+			},
+		},
+		"invalid week": {
+			Input:     `{"week":"MON-FRI"}`,
+			ShouldErr: true,
+		},
+		"invalid range": {
+			Input:     `{"period":"2024-02-01"}`,
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestValue(t *testing.T) {
+	fn := func(in driver.Valuer) (driver.Value, error) {
+		return in.Value()
+	}
+
+	cases := trial.Cases[driver.Valuer, driver.Value]{
+		"week":       {Input: NewWeek(time.Sunday, time.Saturday), Expected: "SUN-SAT"},
+		"zero week":  {Input: Week{}, Expected: "MON-SUN"},
+		"range":      {Input: NewRange(FullMonth(Date(2024, 2, 1))), Expected: "[2024-02-01,2024-03-01)"},
+		"zero range": {Input: Range{}, Expected: nil},
+		"date":       {Input: NewDateOnly(Date(2024, 2, 5)), Expected: Date(2024, 2, 5)},
+		"zero date":  {Input: DateOnly{}, Expected: nil},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestRangeScan(t *testing.T) {
+	fn := func(in any) (Range, error) {
+		var r Range
+		err := r.Scan(in)
+		return r, err
+	}
+
+	cases := trial.Cases[any, Range]{
+		"half open": {
+			Input:    "[2024-02-01,2024-03-01)",
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)),
+		},
+		"open start closed end": {
+			Input:    []byte("(2024-01-31,2024-02-29]"),
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)),
+		},
+		"interval": {
+			Input:    "2024-02-01/2024-02-29",
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)),
+		},
+		"null": {
+			Input:    nil,
+			Expected: Range{},
+		},
+		"wrong type": {
+			Input:     42,
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestDateOnlyScan(t *testing.T) {
+	fn := func(in any) (DateOnly, error) {
+		var d DateOnly
+		err := d.Scan(in)
+		return d, err
+	}
+
+	cases := trial.Cases[any, DateOnly]{
+		"time":   {Input: time.Date(2024, 2, 5, 0, 0, 0, 0, time.FixedZone("", -7*3600)), Expected: NewDateOnly(Date(2024, 2, 5))},
+		"string": {Input: "2024-02-05", Expected: NewDateOnly(Date(2024, 2, 5))},
+		"bytes":  {Input: []byte("2024-02-05"), Expected: NewDateOnly(Date(2024, 2, 5))},
+		"null":   {Input: nil, Expected: DateOnly{}},
+		"invalid": {
+			Input:     "02/05/2024",
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWeekScan(t *testing.T) {
+	fn := func(in any) (Week, error) {
+		var w Week
+		err := w.Scan(in)
+		return w, err
+	}
+
+	cases := trial.Cases[any, Week]{
+		"string": {Input: "SUN-SAT", Expected: NewWeek(time.Sunday, time.Saturday)},
+		"bytes":  {Input: []byte("wed-tue"), Expected: NewWeek(time.Wednesday, time.Tuesday)},
+		"null": {
+			Input:     nil,
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWeekRoundTrip(t *testing.T) {
+	fn := func(in Week) (Week, error) {
+		var text, js, sql Week
+		b, _ := in.MarshalText()
+		if err := text.UnmarshalText(b); err != nil {
+			return Week{}, err
+		}
+		b, _ = json.Marshal(in)
+		if err := json.Unmarshal(b, &js); err != nil {
+			return Week{}, err
+		}
+		v, _ := in.Value()
+		if err := sql.Scan(v); err != nil {
+			return Week{}, err
+		}
+		if text != js || text != sql {
+			t.Errorf("text %v, json %v and sql %v differ", text, js, sql)
+		}
+		return text, nil
+	}
+
+	cases := trial.Cases[Week, Week]{
+		"week": {Input: NewWeek(time.Sunday, time.Saturday), Expected: NewWeek(time.Sunday, time.Saturday)},
+		"zero": {Input: Week{}, Expected: NewWeek(time.Monday, time.Sunday)},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
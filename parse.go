@@ -0,0 +1,253 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnknownPeriod is returned when a period phrase can not be parsed
+var ErrUnknownPeriod = errors.New("unknown period")
+
+type periodFunc func(d Week, t time.Time) Range
+
+// periods maps the supported phrases and their abbreviations to the period functions
+var periods = map[string]periodFunc{
+	"today":     func(_ Week, t time.Time) Range { return NewRange(Day(t), Day(t)) },
+	"yesterday": func(_ Week, t time.Time) Range { return NewRange(Day(t).Add(-OneDay), Day(t).Add(-OneDay)) },
+	"tomorrow":  func(_ Week, t time.Time) Range { return NewRange(Day(t).Add(OneDay), Day(t).Add(OneDay)) },
+
+	"this week":    func(d Week, t time.Time) Range { return NewRange(d.StartOfWeek(t), d.StartOfWeek(t).Add(OneDay*6)) },
+	"week to date": func(d Week, t time.Time) Range { return NewRange(d.StartOfWeek(t), Day(t)) },
+	"wtd":          func(d Week, t time.Time) Range { return NewRange(d.StartOfWeek(t), Day(t)) },
+
+	"last full week":       func(d Week, t time.Time) Range { return NewRange(d.LastFullWeek(t)) },
+	"last week":            func(d Week, t time.Time) Range { return NewRange(d.LastFullWeek(t)) },
+	"previous week":        func(d Week, t time.Time) Range { return NewRange(d.LastFullWeek(t)) },
+	"prior week":           func(d Week, t time.Time) Range { return NewRange(d.LastFullWeek(t)) },
+	"past week":            func(d Week, t time.Time) Range { return NewRange(d.LastFullWeek(t)) },
+	"lfw":                  func(d Week, t time.Time) Range { return NewRange(d.LastFullWeek(t)) },
+	"week before last":     func(d Week, t time.Time) Range { return NewRange(d.PriorLastFullWeek(t)) },
+	"prior last full week": func(d Week, t time.Time) Range { return NewRange(d.PriorLastFullWeek(t)) },
+	"plfw":                 func(d Week, t time.Time) Range { return NewRange(d.PriorLastFullWeek(t)) },
+	"same week last year":  func(d Week, t time.Time) Range { return NewRange(d.PrevYearLastFullWeek(t)) },
+	"last full week last year": func(d Week, t time.Time) Range {
+		return NewRange(d.PrevYearLastFullWeek(t))
+	},
+	"pylfw": func(d Week, t time.Time) Range { return NewRange(d.PrevYearLastFullWeek(t)) },
+	"next week": func(d Week, t time.Time) Range {
+		return NewRange(d.StartOfWeek(t).Add(OneWeek), d.StartOfWeek(t).Add(OneWeek+OneDay*6))
+	},
+
+	"this month":             func(_ Week, t time.Time) Range { return NewRange(FullMonth(t)) },
+	"current month":          func(_ Week, t time.Time) Range { return NewRange(FullMonth(t)) },
+	"full month":             func(_ Week, t time.Time) Range { return NewRange(FullMonth(t)) },
+	"fm":                     func(_ Week, t time.Time) Range { return NewRange(FullMonth(t)) },
+	"month to date":          func(_ Week, t time.Time) Range { return NewRange(MonthToDate(Day(t))) },
+	"mtd":                    func(_ Week, t time.Time) Range { return NewRange(MonthToDate(Day(t))) },
+	"last month":             func(_ Week, t time.Time) Range { return NewRange(PrevMonth(t)) },
+	"previous month":         func(_ Week, t time.Time) Range { return NewRange(PrevMonth(t)) },
+	"prior month":            func(_ Week, t time.Time) Range { return NewRange(PrevMonth(t)) },
+	"past month":             func(_ Week, t time.Time) Range { return NewRange(PrevMonth(t)) },
+	"next month":             func(_ Week, t time.Time) Range { return NewRange(FullMonth(FirstOfNextMonth(t))) },
+	"pm":                     func(_ Week, t time.Time) Range { return NewRange(PrevMonth(t)) },
+	"last month to date":     func(_ Week, t time.Time) Range { return NewRange(PrevMonthToDate(Day(t))) },
+	"previous month to date": func(_ Week, t time.Time) Range { return NewRange(PrevMonthToDate(Day(t))) },
+	"pmtd":                   func(_ Week, t time.Time) Range { return NewRange(PrevMonthToDate(Day(t))) },
+	"same month last year":   func(_ Week, t time.Time) Range { return NewRange(PrevYearMtd(Day(t))) },
+	"same month last year to date": func(_ Week, t time.Time) Range {
+		return NewRange(PrevYearMtd(Day(t)))
+	},
+	"previous year month to date": func(_ Week, t time.Time) Range { return NewRange(PrevYearMtd(Day(t))) },
+	"pymtd":                       func(_ Week, t time.Time) Range { return NewRange(PrevYearMtd(Day(t))) },
+
+	"this quarter":     func(_ Week, t time.Time) Range { return NewRange(FullQuarter(t)) },
+	"current quarter":  func(_ Week, t time.Time) Range { return NewRange(FullQuarter(t)) },
+	"quarter to date":  func(_ Week, t time.Time) Range { return NewRange(QuarterToDate(Day(t))) },
+	"qtd":              func(_ Week, t time.Time) Range { return NewRange(QuarterToDate(Day(t))) },
+	"last quarter":     func(_ Week, t time.Time) Range { return NewRange(PrevQuarter(t)) },
+	"previous quarter": func(_ Week, t time.Time) Range { return NewRange(PrevQuarter(t)) },
+	"prior quarter":    func(_ Week, t time.Time) Range { return NewRange(PrevQuarter(t)) },
+	"past quarter":     func(_ Week, t time.Time) Range { return NewRange(PrevQuarter(t)) },
+	"next quarter":     func(_ Week, t time.Time) Range { return NewRange(FullQuarter(MonthAdd(StartOfQuarter(t), 3))) },
+	"pq":               func(_ Week, t time.Time) Range { return NewRange(PrevQuarter(t)) },
+
+	"this year":             func(_ Week, t time.Time) Range { return NewRange(FullYear(t)) },
+	"current year":          func(_ Week, t time.Time) Range { return NewRange(FullYear(t)) },
+	"year to date":          func(_ Week, t time.Time) Range { return NewRange(YearToDate(Day(t))) },
+	"ytd":                   func(_ Week, t time.Time) Range { return NewRange(YearToDate(Day(t))) },
+	"last year":             func(_ Week, t time.Time) Range { return NewRange(PrevYear(t)) },
+	"previous year":         func(_ Week, t time.Time) Range { return NewRange(PrevYear(t)) },
+	"prior year":            func(_ Week, t time.Time) Range { return NewRange(PrevYear(t)) },
+	"past year":             func(_ Week, t time.Time) Range { return NewRange(PrevYear(t)) },
+	"next year":             func(_ Week, t time.Time) Range { return NewRange(FullYear(YearAdd(t, 1))) },
+	"py":                    func(_ Week, t time.Time) Range { return NewRange(PrevYear(t)) },
+	"last year to date":     func(_ Week, t time.Time) Range { return NewRange(PrevYearToDate(t)) },
+	"previous year to date": func(_ Week, t time.Time) Range { return NewRange(PrevYearToDate(t)) },
+	"pytd":                  func(_ Week, t time.Time) Range { return NewRange(PrevYearToDate(t)) },
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var numbers = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+}
+
+// maxAmount is the largest count of units in a phrase, larger counts would overflow the date arithmetic
+const maxAmount = 1_000_000
+
+// ParsePeriod returns the Range described by the English phrase s relative to the asof date.
+// The phrases map onto the period functions and week methods, for example
+//
+//	"last full week" or "lfw"          LastFullWeek
+//	"month to date" or "mtd"           MonthToDate
+//	"same month last year" or "pymtd"  PrevYearMtd
+//	"previous quarter"                 PrevQuarter
+//	"next month"                       the full month after asof
+//
+// Single dates are returned as a Range with the same Start and End, these include
+// "today", "next friday", "last tuesday", "this monday", "two months ago", "in 3 days"
+// and ISO dates like "2024-02-05". Rolling windows like "last 7 days" or "past 2 weeks"
+// end the day before asof, while a unit without a count like "past month" is the previous
+// calendar period. An error wrapping ErrUnknownPeriod is returned for anything else.
+func (d Week) ParsePeriod(s string, asof time.Time) (Range, error) {
+	asof = Day(asof)
+	phrase := normalizePhrase(s)
+
+	if fn, ok := periods[phrase]; ok {
+		return fn(d, asof), nil
+	}
+	if t, err := time.Parse(time.DateOnly, phrase); err == nil {
+		return NewRange(t, t), nil
+	}
+
+	words := strings.Fields(phrase)
+	switch {
+	case len(words) == 1:
+		// a weekday on its own is the day in the current week
+		if wd, ok := weekdays[words[0]]; ok {
+			t := d.weekday(asof, wd)
+			return NewRange(t, t), nil
+		}
+	case len(words) == 2:
+		wd, ok := weekdays[words[1]]
+		if !ok {
+			break
+		}
+		var t time.Time
+		switch words[0] {
+		case "this":
+			t = d.weekday(asof, wd)
+		case "next":
+			t = asof.Add(OneDay * time.Duration((int(wd)-int(asof.Weekday())+7)%7))
+			if t.Equal(asof) {
+				t = t.Add(OneWeek)
+			}
+		case "last", "previous", "prior":
+			t = asof.Add(-OneDay * time.Duration((int(asof.Weekday())-int(wd)+7)%7))
+			if t.Equal(asof) {
+				t = t.Add(-OneWeek)
+			}
+		default:
+			return Range{}, fmt.Errorf("%w: %q", ErrUnknownPeriod, s)
+		}
+		return NewRange(t, t), nil
+	case len(words) == 3 && words[2] == "ago":
+		// two months ago
+		if n, unit, ok := parseAmount(words[0], words[1]); ok {
+			t := addUnits(asof, unit, -n)
+			return NewRange(t, t), nil
+		}
+	case len(words) == 3 && words[0] == "in":
+		// in 3 days
+		if n, unit, ok := parseAmount(words[1], words[2]); ok {
+			t := addUnits(asof, unit, n)
+			return NewRange(t, t), nil
+		}
+	case len(words) == 3 && (words[0] == "last" || words[0] == "past" || words[0] == "previous"):
+		// last 7 days
+		if n, unit, ok := parseAmount(words[1], words[2]); ok && n > 0 {
+			end := asof.Add(-OneDay)
+			return NewRange(addUnits(asof, unit, -n), end), nil
+		}
+	}
+
+	return Range{}, fmt.Errorf("%w: %q", ErrUnknownPeriod, s)
+}
+
+// weekday returns the date of weekday wd in the week of t
+func (d Week) weekday(t time.Time, wd time.Weekday) time.Time {
+	start := d.StartOfWeek(t)
+	return start.Add(OneDay * time.Duration((int(wd)-int(d.weekStart)+7)%7))
+}
+
+// normalizePhrase lower cases s, removes punctuation and extra spaces.
+// A hyphen is only a space between words like "month-to-date", so "-3 days ago" stays invalid
+func normalizePhrase(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if _, err := time.Parse(time.DateOnly, s); err == nil {
+		return s
+	}
+	b := []byte(s)
+	for i := 1; i < len(b)-1; i++ {
+		if b[i] == '-' && isWordByte(b[i-1]) && isWordByte(b[i+1]) {
+			b[i] = ' '
+		}
+	}
+	s = strings.NewReplacer("_", " ", ",", " ", ".", " ").Replace(string(b))
+	words := strings.Fields(s)
+	if len(words) > 1 && words[0] == "the" {
+		words = words[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// isWordByte reports whether c is a letter or digit
+func isWordByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || '0' <= c && c <= '9'
+}
+
+// parseAmount parses a count and unit like "two months" or "3 days"
+func parseAmount(count, unit string) (int, string, bool) {
+	n, ok := numbers[count]
+	if !ok {
+		var err error
+		if n, err = strconv.Atoi(count); err != nil || n < 0 || n > maxAmount {
+			return 0, "", false
+		}
+	}
+	unit = strings.TrimSuffix(unit, "s")
+	switch unit {
+	case "day", "week", "month", "quarter", "year":
+		return n, unit, true
+	}
+	return 0, "", false
+}
+
+// addUnits adds n units (day, week, month, quarter or year) to t
+func addUnits(t time.Time, unit string, n int) time.Time {
+	switch unit {
+	case "day":
+		return t.AddDate(0, 0, n)
+	case "week":
+		return t.AddDate(0, 0, 7*n)
+	case "month":
+		return MonthAdd(t, n)
+	case "quarter":
+		return MonthAdd(t, n*3)
+	case "year":
+		return YearAdd(t, n)
+	}
+	return t
+}
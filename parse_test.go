@@ -0,0 +1,145 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestParsePeriod(t *testing.T) {
+	d := NewWeek(time.Monday, time.Sunday)
+	asof := Date(2024, 2, 7) // Wednesday
+	fn := func(in string) (Range, error) {
+		return d.ParsePeriod(in, asof)
+	}
+
+	day := func(y int, m time.Month, d int) Range {
+		return NewRange(Date(y, m, d), Date(y, m, d))
+	}
+
+	cases := trial.Cases[string, Range]{
+		"last full week": {
+			Input:    "last full week",
+			Expected: NewRange(Date(2024, 1, 29), Date(2024, 2, 4)),
+		},
+		"abbreviation": {
+			Input:    "LFW",
+			Expected: NewRange(Date(2024, 1, 29), Date(2024, 2, 4)),
+		},
+		"month to date": {
+			Input:    "Month-to-date",
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 7)),
+		},
+		"same month last year": {
+			Input:    "same month last year",
+			Expected: NewRange(Date(2023, 2, 1), Date(2023, 2, 7)),
+		},
+		"same month last year to date": {
+			Input:    "same month last year to date",
+			Expected: NewRange(Date(2023, 2, 1), Date(2023, 2, 7)),
+		},
+		"previous quarter": {
+			Input:    "the previous quarter",
+			Expected: NewRange(Date(2023, 10, 1), Date(2023, 12, 31)),
+		},
+		"next friday": {
+			Input:    "next friday",
+			Expected: day(2024, 2, 9),
+		},
+		"next wednesday": {
+			Input:    "next wednesday",
+			Expected: day(2024, 2, 14),
+		},
+		"last tuesday": {
+			Input:    "last tuesday",
+			Expected: day(2024, 2, 6),
+		},
+		"this monday": {
+			Input:    "this monday",
+			Expected: day(2024, 2, 5),
+		},
+		"two months ago": {
+			Input:    "two months ago",
+			Expected: day(2023, 12, 7),
+		},
+		"in 3 days": {
+			Input:    "in 3 days",
+			Expected: day(2024, 2, 10),
+		},
+		"last 7 days": {
+			Input:    "last 7 days",
+			Expected: NewRange(Date(2024, 1, 31), Date(2024, 2, 6)),
+		},
+		"iso date": {
+			Input:    "2024-03-01",
+			Expected: day(2024, 3, 1),
+		},
+		"next week": {
+			Input:    "next week",
+			Expected: NewRange(Date(2024, 2, 12), Date(2024, 2, 18)),
+		},
+		"past week": {
+			Input:    "past week",
+			Expected: NewRange(Date(2024, 1, 29), Date(2024, 2, 4)),
+		},
+		"next month": {
+			Input:    "next month",
+			Expected: NewRange(Date(2024, 3, 1), Date(2024, 3, 31)),
+		},
+		"past month": {
+			Input:    "past month",
+			Expected: NewRange(Date(2024, 1, 1), Date(2024, 1, 31)),
+		},
+		"next quarter": {
+			Input:    "next quarter",
+			Expected: NewRange(Date(2024, 4, 1), Date(2024, 6, 30)),
+		},
+		"past quarter": {
+			Input:    "past quarter",
+			Expected: NewRange(Date(2023, 10, 1), Date(2023, 12, 31)),
+		},
+		"next year": {
+			Input:    "next year",
+			Expected: NewRange(Date(2025, 1, 1), Date(2025, 12, 31)),
+		},
+		"past year": {
+			Input:    "past year",
+			Expected: NewRange(Date(2023, 1, 1), Date(2023, 12, 31)),
+		},
+		"hyphenated words": {
+			Input:    "last-full-week",
+			Expected: NewRange(Date(2024, 1, 29), Date(2024, 2, 4)),
+		},
+		"negative amount": {
+			Input:       "-3 days ago",
+			ExpectedErr: ErrUnknownPeriod,
+		},
+		"many weeks": {
+			Input:    "in 20000 weeks",
+			Expected: day(2407, 5, 30),
+		},
+		"last many weeks": {
+			Input:    "last 20000 weeks",
+			Expected: NewRange(Date(1640, 10, 17), Date(2024, 2, 6)),
+		},
+		"too many years": {
+			Input:       "in 99999999999 years",
+			ExpectedErr: ErrUnknownPeriod,
+		},
+		"negative in": {
+			Input:       "in -3 days",
+			ExpectedErr: ErrUnknownPeriod,
+		},
+		"unknown": {
+			Input:       "a fortnight from now",
+			ExpectedErr: ErrUnknownPeriod,
+		},
+		"unknown weekday phrase": {
+			Input:       "every friday",
+			ExpectedErr: ErrUnknownPeriod,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
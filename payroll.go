@@ -0,0 +1,88 @@
+package dates
+
+import "time"
+
+// PayFrequency is how often a PaySchedule pays
+type PayFrequency int
+
+const (
+	Weekly      PayFrequency = iota // every week on the weekday of the anchor
+	Biweekly                        // every other week from the anchor
+	Semimonthly                     // on the 15th and the last day of the month
+	Monthly                         // on the last day of the month
+)
+
+// PaySchedule generates the pay dates of a payroll. A pay date that falls on a weekend
+// or holiday of the calendar is paid early on the previous business day.
+type PaySchedule struct {
+	Frequency PayFrequency
+	Anchor    time.Time // a pay date of a weekly or biweekly schedule, i.e., the first pay date of the year
+	Calendar  Calendar
+}
+
+// PayPeriod is the period of work paid on a pay date
+type PayPeriod struct {
+	Period  Range
+	PayDate time.Time
+}
+
+// nominal returns the unadjusted pay dates from start to end with the period each one pays for
+func (p PaySchedule) nominal(start, end time.Time) []PayPeriod {
+	var periods []PayPeriod
+	switch p.Frequency {
+	case Weekly, Biweekly:
+		weeks := 1
+		if p.Frequency == Biweekly {
+			weeks = 2
+		}
+		anchor := Day(p.Anchor)
+		n := DaysBetween(anchor, start) / (7 * weeks)
+		for t := WeekAdd(anchor, n*weeks); !t.After(end); t = WeekAdd(t, weeks) {
+			if t.Before(start) {
+				continue
+			}
+			periods = append(periods, PayPeriod{
+				Period:  NewRange(WeekAdd(t, -weeks).Add(OneDay), t),
+				PayDate: t,
+			})
+		}
+	case Semimonthly:
+		for t := StartOfMonth(start); !t.After(end); t = FirstOfNextMonth(t) {
+			mid, last := Date(t.Year(), t.Month(), 15), LastDayOfMonth(t)
+			periods = append(periods,
+				PayPeriod{Period: NewRange(t, mid), PayDate: mid},
+				PayPeriod{Period: NewRange(mid.Add(OneDay), last), PayDate: last},
+			)
+		}
+	case Monthly:
+		for t := StartOfMonth(start); !t.After(end); t = FirstOfNextMonth(t) {
+			periods = append(periods, PayPeriod{Period: NewRange(FullMonth(t)), PayDate: LastDayOfMonth(t)})
+		}
+	}
+	return periods
+}
+
+// PayPeriods returns the pay periods paid in the year in order of their pay dates.
+// Weekly and biweekly periods end on the scheduled pay date, semimonthly periods are
+// the 1st to the 15th and the 16th to the end of the month and monthly periods are the month.
+func (p PaySchedule) PayPeriods(year int) []PayPeriod {
+	var periods []PayPeriod
+	// pay dates at the start of next year may be paid early in this year
+	for _, pp := range p.nominal(Date(year, time.January, 1), Date(year+1, time.January, 14)) {
+		pp.PayDate = Adjust(pp.PayDate, Preceding, p.Calendar)
+		if pp.PayDate.Year() == year {
+			periods = append(periods, pp)
+		}
+	}
+	return periods
+}
+
+// PayDates returns the dates paid in the year
+func (p PaySchedule) PayDates(year int) []time.Time {
+	periods := p.PayPeriods(year)
+	dates := make([]time.Time, len(periods))
+	for i, pp := range periods {
+		dates[i] = pp.PayDate
+	}
+	return dates
+}
@@ -0,0 +1,103 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestPayDates(t *testing.T) {
+	type input struct {
+		schedule PaySchedule
+		year     int
+	}
+	type result struct {
+		count       int
+		first, last time.Time
+		includes    []time.Time
+	}
+	fn := func(in input) (result, error) {
+		dates := in.schedule.PayDates(in.year)
+		r := result{count: len(dates), first: dates[0], last: dates[len(dates)-1]}
+		for _, d := range dates {
+			switch d.Month() {
+			case time.March, time.June, time.July:
+				r.includes = append(r.includes, d)
+			}
+		}
+		return r, nil
+	}
+
+	cases := trial.Cases[input, result]{
+		"biweekly": {
+			Input: input{PaySchedule{Frequency: Biweekly, Anchor: Date(2024, 1, 5), Calendar: USFederal}, 2024},
+			Expected: result{count: 26, first: Date(2024, 1, 5), last: Date(2024, 12, 20), includes: []time.Time{
+				Date(2024, 3, 1), Date(2024, 3, 15), Date(2024, 3, 29), Date(2024, 6, 7), Date(2024, 6, 21),
+				Date(2024, 7, 5), Date(2024, 7, 19),
+			}},
+		},
+		"biweekly anchored in a later year": {
+			Input: input{PaySchedule{Frequency: Biweekly, Anchor: Date(2025, 1, 3), Calendar: USFederal}, 2023},
+			Expected: result{count: 26, first: Date(2023, 1, 6), last: Date(2023, 12, 22), includes: []time.Time{
+				Date(2023, 3, 3), Date(2023, 3, 17), Date(2023, 3, 31), Date(2023, 6, 9), Date(2023, 6, 23),
+				Date(2023, 7, 7), Date(2023, 7, 21),
+			}},
+		},
+		"weekly holidays paid early": {
+			Input: input{PaySchedule{Frequency: Weekly, Anchor: Date(2026, 1, 2), Calendar: USFederal}, 2026},
+			Expected: result{count: 53, first: Date(2026, 1, 2), last: Date(2026, 12, 31), includes: []time.Time{
+				Date(2026, 3, 6), Date(2026, 3, 13), Date(2026, 3, 20), Date(2026, 3, 27),
+				Date(2026, 6, 5), Date(2026, 6, 12), Date(2026, 6, 18), Date(2026, 6, 26),
+				Date(2026, 7, 2), Date(2026, 7, 10), Date(2026, 7, 17), Date(2026, 7, 24), Date(2026, 7, 31),
+			}},
+		},
+		"semimonthly": {
+			Input: input{PaySchedule{Frequency: Semimonthly, Calendar: USFederal}, 2024},
+			Expected: result{count: 24, first: Date(2024, 1, 12), last: Date(2024, 12, 31), includes: []time.Time{
+				Date(2024, 3, 15), Date(2024, 3, 29), Date(2024, 6, 14), Date(2024, 6, 28),
+				Date(2024, 7, 15), Date(2024, 7, 31),
+			}},
+		},
+		"monthly last business day": {
+			Input: input{PaySchedule{Frequency: Monthly, Calendar: USFederal}, 2024},
+			Expected: result{count: 12, first: Date(2024, 1, 31), last: Date(2024, 12, 31), includes: []time.Time{
+				Date(2024, 3, 29), Date(2024, 6, 28), Date(2024, 7, 31),
+			}},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPayPeriods(t *testing.T) {
+	fn := func(in PaySchedule) ([]PayPeriod, error) {
+		return in.PayPeriods(2024)[:2], nil
+	}
+
+	cases := trial.Cases[PaySchedule, []PayPeriod]{
+		"biweekly": {
+			Input: PaySchedule{Frequency: Biweekly, Anchor: Date(2024, 1, 5), Calendar: USFederal},
+			Expected: []PayPeriod{
+				{Period: NewRange(Date(2023, 12, 23), Date(2024, 1, 5)), PayDate: Date(2024, 1, 5)},
+				{Period: NewRange(Date(2024, 1, 6), Date(2024, 1, 19)), PayDate: Date(2024, 1, 19)},
+			},
+		},
+		"semimonthly": {
+			Input: PaySchedule{Frequency: Semimonthly, Calendar: USFederal},
+			Expected: []PayPeriod{
+				{Period: NewRange(Date(2024, 1, 1), Date(2024, 1, 15)), PayDate: Date(2024, 1, 12)},
+				{Period: NewRange(Date(2024, 1, 16), Date(2024, 1, 31)), PayDate: Date(2024, 1, 31)},
+			},
+		},
+		"monthly": {
+			Input: PaySchedule{Frequency: Monthly, Calendar: USFederal},
+			Expected: []PayPeriod{
+				{Period: NewRange(Date(2024, 1, 1), Date(2024, 1, 31)), PayDate: Date(2024, 1, 31)},
+				{Period: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)), PayDate: Date(2024, 2, 29)},
+			},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
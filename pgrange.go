@@ -0,0 +1,231 @@
+package dates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Bound is how the lower or upper value of a PgRange is bounded
+type Bound int
+
+const (
+	Inclusive Bound = iota // [ or ], the value is part of the range
+	Exclusive              // ( or ), the value is not part of the range
+	Unbounded              // no limit, an empty value or infinity
+)
+
+// PgRange is a PostgreSQL range literal of dates or timestamps
+// (daterange, tsrange, tstzrange), i.e., "[2024-01-01,2024-02-01)".
+// The package Range is a closed range, use PgRange.Range and Range.PgRange
+// to convert between the two.
+type PgRange struct {
+	Lower      time.Time
+	Upper      time.Time
+	LowerBound Bound
+	UpperBound Bound
+	Empty      bool // the range "empty" that contains no values
+}
+
+// pgLayouts are the time formats accepted for range values
+var pgLayouts = []string{
+	time.DateOnly,
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+}
+
+// ParsePgRange parses a PostgreSQL range literal like "[2024-01-01,2024-02-01)",
+// "(,2024-02-01]", "[2024-01-01,infinity)", "empty" or
+// `["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00")`
+func ParsePgRange(s string) (PgRange, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "empty") {
+		return PgRange{Empty: true}, nil
+	}
+	if len(s) < 3 || !strings.ContainsAny(s[:1], "[(") || !strings.ContainsAny(s[len(s)-1:], "])") {
+		return PgRange{}, fmt.Errorf("invalid range literal %q: expected [lower,upper)", s)
+	}
+
+	values, err := splitPgRange(s[1 : len(s)-1])
+	if err != nil {
+		return PgRange{}, fmt.Errorf("invalid range literal %q: %w", s, err)
+	}
+
+	var p PgRange
+	p.LowerBound, p.UpperBound = Inclusive, Inclusive
+	if s[0] == '(' {
+		p.LowerBound = Exclusive
+	}
+	if s[len(s)-1] == ')' {
+		p.UpperBound = Exclusive
+	}
+	if p.Lower, err = parsePgValue(values[0]); err != nil {
+		return PgRange{}, fmt.Errorf("invalid range literal %q: %w", s, err)
+	}
+	if p.Upper, err = parsePgValue(values[1]); err != nil {
+		return PgRange{}, fmt.Errorf("invalid range literal %q: %w", s, err)
+	}
+	if p.Lower.IsZero() {
+		p.LowerBound = Unbounded
+	}
+	if p.Upper.IsZero() {
+		p.UpperBound = Unbounded
+	}
+	return p, nil
+}
+
+// splitPgRange splits the inside of a range literal into the lower and upper values,
+// values may be double quoted with \" or "" escapes
+func splitPgRange(s string) ([2]string, error) {
+	var values [2]string
+	var b strings.Builder
+	idx, quoted := 0, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case c == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			if idx > 0 {
+				return values, fmt.Errorf("too many values")
+			}
+			values[idx] = b.String()
+			b.Reset()
+			idx++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if idx != 1 {
+		return values, fmt.Errorf("expected a lower and upper value")
+	}
+	values[1] = b.String()
+	return values, nil
+}
+
+// parsePgValue parses a date or timestamp, the zero time is returned for an empty or infinite value
+func parsePgValue(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "", "infinity", "-infinity":
+		return time.Time{}, nil
+	}
+	for _, layout := range pgLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date or timestamp %q", s)
+}
+
+// isDate reports whether t has no time of day
+func isDate(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// String returns the range literal, dates are written as YYYY-MM-DD
+// and timestamps quoted with their UTC offset
+func (p PgRange) String() string {
+	if p.Empty {
+		return "empty"
+	}
+	format := func(t time.Time, b Bound) string {
+		if b == Unbounded {
+			return ""
+		}
+		if isDate(t) && t.Location() == time.UTC {
+			return t.Format(time.DateOnly)
+		}
+		return `"` + t.Format("2006-01-02 15:04:05.999999-07") + `"`
+	}
+
+	opening, closing := "[", ")"
+	if p.LowerBound != Inclusive {
+		opening = "("
+	}
+	if p.UpperBound == Inclusive {
+		closing = "]"
+	}
+	return opening + format(p.Lower, p.LowerBound) + "," + format(p.Upper, p.UpperBound) + closing
+}
+
+// Contains reports whether t is within the range honoring the bounds
+func (p PgRange) Contains(t time.Time) bool {
+	if p.Empty {
+		return false
+	}
+	switch p.LowerBound {
+	case Inclusive:
+		if t.Before(p.Lower) {
+			return false
+		}
+	case Exclusive:
+		if !t.After(p.Lower) {
+			return false
+		}
+	}
+	switch p.UpperBound {
+	case Inclusive:
+		if t.After(p.Upper) {
+			return false
+		}
+	case Exclusive:
+		if !t.Before(p.Upper) {
+			return false
+		}
+	}
+	return true
+}
+
+// Range returns the closed range of the days in the range, for example
+// [2024-01-01,2024-02-01) is Jan 1st to Jan 31st. Exclusive date bounds move one day in,
+// a timestamp bound includes its whole day. An error is returned for an empty range,
+// an unbounded range or one that has no whole days.
+func (p PgRange) Range() (Range, error) {
+	if p.Empty {
+		return Range{}, fmt.Errorf("range %s is empty", p)
+	}
+	if p.LowerBound == Unbounded || p.UpperBound == Unbounded {
+		return Range{}, fmt.Errorf("range %s is unbounded", p)
+	}
+
+	start, end := Day(p.Lower), Day(p.Upper)
+	if p.LowerBound == Exclusive && isDate(p.Lower) {
+		start = start.Add(OneDay)
+	}
+	if p.UpperBound == Exclusive && isDate(p.Upper) {
+		end = end.Add(-OneDay)
+	}
+	if end.Before(start) {
+		return Range{}, fmt.Errorf("range %s has no days", p)
+	}
+	return NewRange(start, end), nil
+}
+
+// PgRange returns the range in the canonical half open form of a daterange,
+// i.e., Feb 1st to Feb 29th is [2024-02-01,2024-03-01)
+func (r Range) PgRange() PgRange {
+	start, end := r.HalfOpen()
+	return PgRange{
+		Lower:      start,
+		Upper:      end,
+		LowerBound: Inclusive,
+		UpperBound: Exclusive,
+	}
+}
+
+// HalfOpen returns the start of the range and the day after the end of the range,
+// so a time t is in the range when start <= t < end
+func (r Range) HalfOpen() (start, end time.Time) {
+	return Day(r.Start), Day(r.End).Add(OneDay)
+}
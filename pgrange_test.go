@@ -0,0 +1,162 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestParsePgRange(t *testing.T) {
+	fn := func(in string) (PgRange, error) {
+		return ParsePgRange(in)
+	}
+
+	cases := trial.Cases[string, PgRange]{
+		"half open": {
+			Input:    "[2024-01-01,2024-02-01)",
+			Expected: PgRange{Lower: Date(2024, 1, 1), Upper: Date(2024, 2, 1), LowerBound: Inclusive, UpperBound: Exclusive},
+		},
+		"closed": {
+			Input:    "[2024-01-01,2024-01-31]",
+			Expected: PgRange{Lower: Date(2024, 1, 1), Upper: Date(2024, 1, 31), LowerBound: Inclusive, UpperBound: Inclusive},
+		},
+		"unbounded lower": {
+			Input:    "(,2024-02-01]",
+			Expected: PgRange{Upper: Date(2024, 2, 1), LowerBound: Unbounded, UpperBound: Inclusive},
+		},
+		"infinity": {
+			Input:    "[2024-01-01,infinity)",
+			Expected: PgRange{Lower: Date(2024, 1, 1), LowerBound: Inclusive, UpperBound: Unbounded},
+		},
+		"empty": {
+			Input:    "empty",
+			Expected: PgRange{Empty: true},
+		},
+		"tstzrange": {
+			Input: `["2024-01-01 00:00:00+00","2024-02-01 12:30:00-05")`,
+			Expected: PgRange{
+				Lower:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Upper:      time.Date(2024, 2, 1, 12, 30, 0, 0, time.FixedZone("", -5*3600)),
+				LowerBound: Inclusive,
+				UpperBound: Exclusive,
+			},
+		},
+		"missing bracket": {
+			Input:     "2024-01-01,2024-02-01)",
+			ShouldErr: true,
+		},
+		"one value": {
+			Input:     "[2024-01-01)",
+			ShouldErr: true,
+		},
+		"bad date": {
+			Input:     "[2024-13-01,2024-02-01)",
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPgRangeString(t *testing.T) {
+	fn := func(in PgRange) (string, error) {
+		return in.String(), nil
+	}
+
+	cases := trial.Cases[PgRange, string]{
+		"daterange": {
+			Input:    NewRange(FullMonth(Date(2024, 2, 1))).PgRange(),
+			Expected: "[2024-02-01,2024-03-01)",
+		},
+		"unbounded": {
+			Input:    PgRange{Lower: Date(2024, 1, 1), LowerBound: Exclusive, UpperBound: Unbounded},
+			Expected: "(2024-01-01,)",
+		},
+		"timestamp": {
+			Input: PgRange{
+				Lower:      time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC),
+				Upper:      time.Date(2024, 1, 2, 6, 0, 0, 0, time.UTC),
+				UpperBound: Inclusive,
+			},
+			Expected: `["2024-01-01 06:00:00+00","2024-01-02 06:00:00+00"]`,
+		},
+		"empty": {
+			Input:    PgRange{Empty: true},
+			Expected: "empty",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPgRangeRange(t *testing.T) {
+	fn := func(in string) (Range, error) {
+		p, err := ParsePgRange(in)
+		if err != nil {
+			return Range{}, err
+		}
+		return p.Range()
+	}
+
+	cases := trial.Cases[string, Range]{
+		"half open": {
+			Input:    "[2024-02-01,2024-03-01)",
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)),
+		},
+		"open": {
+			Input:    "(2024-01-31,2024-03-01)",
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)),
+		},
+		"timestamps include the partial day": {
+			Input:    `["2024-02-01 08:00:00+00","2024-02-29 17:00:00+00")`,
+			Expected: NewRange(Date(2024, 2, 1), Date(2024, 2, 29)),
+		},
+		"unbounded": {
+			Input:     "[2024-02-01,)",
+			ShouldErr: true,
+		},
+		"empty": {
+			Input:     "empty",
+			ShouldErr: true,
+		},
+		"no days": {
+			Input:     "[2024-02-01,2024-02-01)",
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestPgRangeContains(t *testing.T) {
+	p, _ := ParsePgRange("(2024-01-01,2024-02-01)")
+	fn := func(in time.Time) (bool, error) {
+		return p.Contains(in), nil
+	}
+
+	cases := trial.Cases[time.Time, bool]{
+		"exclusive lower": {Input: Date(2024, 1, 1), Expected: false},
+		"after lower":     {Input: time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), Expected: true},
+		"exclusive upper": {Input: Date(2024, 2, 1), Expected: false},
+		"before upper":    {Input: Date(2024, 1, 31), Expected: true},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestHalfOpen(t *testing.T) {
+	fn := func(in Range) (output, error) {
+		start, end := in.HalfOpen()
+		return output{start: start, end: end}, nil
+	}
+
+	cases := trial.Cases[Range, output]{
+		"month": {
+			Input:    NewRange(FullMonth(Date(2023, 12, 1))),
+			Expected: output{start: Date(2023, 12, 1), end: Date(2024, 1, 1)},
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
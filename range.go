@@ -0,0 +1,48 @@
+package dates
+
+import (
+	"time"
+)
+
+// Range is a closed range of dates, both the Start and End dates are included.
+// For example the Range for February 2024 is Feb 1st to Feb 29th
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewRange returns a Range of the start and end dates.
+// It can wrap any of the period functions, for example NewRange(FullMonth(t))
+func NewRange(start, end time.Time) Range {
+	return Range{Start: start, End: end}
+}
+
+// Contains reports whether the day of t is within the range
+func (r Range) Contains(t time.Time) bool {
+	t = Day(t)
+	return !t.Before(Day(r.Start)) && !t.After(Day(r.End))
+}
+
+// Equal reports whether both ranges have the same start and end dates
+func (r Range) Equal(o Range) bool {
+	return r.Start.Equal(o.Start) && r.End.Equal(o.End)
+}
+
+// Days returns the number of days in the range including the start and end dates
+func (r Range) Days() int {
+	return DaysBetween(r.Start, r.End) + 1
+}
+
+// Each returns every date in the range in order
+func (r Range) Each() []time.Time {
+	days := make([]time.Time, 0, max(r.Days(), 0))
+	for t := Day(r.Start); !t.After(Day(r.End)); t = t.AddDate(0, 0, 1) {
+		days = append(days, t)
+	}
+	return days
+}
+
+// String returns the range as an ISO 8601 interval, i.e., 2024-01-29/2024-02-04
+func (r Range) String() string {
+	return r.Start.Format(time.DateOnly) + "/" + r.End.Format(time.DateOnly)
+}
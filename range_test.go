@@ -0,0 +1,50 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestRangeContains(t *testing.T) {
+	r := NewRange(FullMonth(Date(2024, 2, 15)))
+	fn := func(in time.Time) (bool, error) {
+		return r.Contains(in), nil
+	}
+
+	cases := trial.Cases[time.Time, bool]{
+		"start":         {Input: Date(2024, 2, 1), Expected: true},
+		"end with time": {Input: time.Date(2024, 2, 29, 23, 59, 0, 0, time.UTC), Expected: true},
+		"before":        {Input: Date(2024, 1, 31), Expected: false},
+		"after":         {Input: Date(2024, 3, 1), Expected: false},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestRangeDays(t *testing.T) {
+	fn := func(in Range) (int, error) {
+		return in.Days(), nil
+	}
+
+	cases := trial.Cases[Range, int]{
+		"leap month": {Input: NewRange(FullMonth(Date(2024, 2, 1))), Expected: 29},
+		"one day":    {Input: NewRange(Date(2024, 2, 1), Date(2024, 2, 1)), Expected: 1},
+		"week":       {Input: NewRange(NewWeek(time.Monday, time.Sunday).LastFullWeek(Date(2024, 2, 5))), Expected: 7},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestRangeString(t *testing.T) {
+	fn := func(in Range) (string, error) {
+		return in.String(), nil
+	}
+
+	cases := trial.Cases[Range, string]{
+		"month": {Input: NewRange(FullMonth(Date(2024, 2, 1))), Expected: "2024-02-01/2024-02-29"},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
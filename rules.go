@@ -0,0 +1,256 @@
+package dates
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ordinals = map[string]int{
+	"first": 1, "1st": 1, "second": 2, "2nd": 2, "third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4, "fifth": 5, "5th": 5, "last": -1,
+}
+
+// ParseRule returns the holiday described by a rule. A rule is a date followed by modifiers
+//
+//	fixed 07-04                             July 4th
+//	3rd monday january                      the nth (first to fifth or last) weekday of a month
+//	first tuesday after first monday november
+//	easter -2                               Easter Sunday, here Good Friday
+//
+// The date may be followed by a number of days to add like "4th thursday november +1" and the modifiers
+//
+//	observed [nearest-weekday]   Saturday is observed on Friday and Sunday on Monday, see Observed
+//	observed monday              Saturday and Sunday are observed on Monday, see ObservedMonday
+//	since 2021                   the first year of the holiday, see Since
+//	until 2030                   the last year of the holiday, see Until
+//
+// There is no holiday in the years without the date, like Feb 29th or a 5th Monday in February.
+func ParseRule(rule string) (HolidayFunc, error) {
+	words := strings.Fields(strings.ToLower(rule))
+	fn, words, err := parseRuleDate(words)
+	if err != nil {
+		return nil, fmt.Errorf("invalid holiday rule %q: %w", rule, err)
+	}
+
+	for len(words) > 0 {
+		word := words[0]
+		words = words[1:]
+		switch {
+		case strings.HasPrefix(word, "+") || strings.HasPrefix(word, "-"):
+			days, err := strconv.Atoi(word)
+			if err != nil {
+				return nil, fmt.Errorf("invalid holiday rule %q: invalid offset %q", rule, word)
+			}
+			fn = offsetHoliday(fn, days)
+		case word == "observed":
+			observed := Observed
+			if len(words) > 0 {
+				switch words[0] {
+				case "nearest-weekday":
+					words = words[1:]
+				case "monday":
+					observed, words = ObservedMonday, words[1:]
+				}
+			}
+			fn = observed(fn)
+		case word == "since" || word == "until":
+			if len(words) == 0 {
+				return nil, fmt.Errorf("invalid holiday rule %q: %s requires a year", rule, word)
+			}
+			year, err := strconv.Atoi(words[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid holiday rule %q: invalid year %q", rule, words[0])
+			}
+			words = words[1:]
+			if word == "since" {
+				fn = Since(year, fn)
+			} else {
+				fn = Until(year, fn)
+			}
+		default:
+			return nil, fmt.Errorf("invalid holiday rule %q: unknown modifier %q", rule, word)
+		}
+	}
+	return fn, nil
+}
+
+// parseRuleDate returns the holiday of the date at the start of the rule and the words after it
+func parseRuleDate(words []string) (HolidayFunc, []string, error) {
+	if len(words) == 0 {
+		return nil, nil, fmt.Errorf("empty rule")
+	}
+	switch words[0] {
+	case "fixed":
+		if len(words) < 2 {
+			return nil, nil, fmt.Errorf("fixed requires a date like 07-04")
+		}
+		t, err := time.Parse("01-02", words[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid date %q, expected MM-DD", words[1])
+		}
+		month, day := t.Month(), t.Day()
+		return func(date time.Time) time.Time {
+			t := Date(date.Year(), month, day)
+			if t.Month() != month {
+				return time.Time{} // Feb 29th in a common year
+			}
+			return t
+		}, words[2:], nil
+	case "easter":
+		return Easter, words[1:], nil
+	}
+
+	if len(words) >= 3 && words[2] == "after" {
+		// i.e., first tuesday after first monday november
+		if len(words) < 6 {
+			return nil, nil, fmt.Errorf("after requires a rule like \"first monday november\"")
+		}
+		n, wd, err := parseOrdinalWeekday(words)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n < 1 {
+			return nil, nil, fmt.Errorf("%q can not be used with after", words[0])
+		}
+		m, after, month, err := parseNthWeekday(words[3:6])
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(date time.Time) time.Time {
+			t := nthWeekday(date.Year(), month, after, m)
+			if t.Month() != month {
+				return time.Time{}
+			}
+			t = t.AddDate(0, 0, (int(wd)-int(t.Weekday())+6)%7+1)
+			return WeekAdd(t, n-1)
+		}, words[6:], nil
+	}
+
+	n, wd, month, err := parseNthWeekday(words)
+	if err != nil {
+		return nil, nil, err
+	}
+	return func(date time.Time) time.Time {
+		t := nthWeekday(date.Year(), month, wd, n)
+		if t.Month() != month {
+			return time.Time{} // a 5th weekday the month does not have
+		}
+		return t
+	}, words[3:], nil
+}
+
+// parseNthWeekday parses the first 3 words of a rule like "3rd monday january" or "last monday may"
+func parseNthWeekday(words []string) (n int, wd time.Weekday, month time.Month, err error) {
+	if len(words) < 3 {
+		return 0, 0, 0, fmt.Errorf("expected fixed, easter or a rule like \"3rd monday january\"")
+	}
+	if n, wd, err = parseOrdinalWeekday(words); err != nil {
+		return 0, 0, 0, err
+	}
+	month, ok := parseMonth(words[2])
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("unknown month %q", words[2])
+	}
+	return n, wd, month, nil
+}
+
+// parseOrdinalWeekday parses the first 2 words of a rule like "3rd monday"
+func parseOrdinalWeekday(words []string) (n int, wd time.Weekday, err error) {
+	n, ok := ordinals[words[0]]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown ordinal %q", words[0])
+	}
+	if wd, ok = weekdays[words[1]]; !ok {
+		return 0, 0, fmt.Errorf("unknown weekday %q", words[1])
+	}
+	return n, wd, nil
+}
+
+// parseMonth parses a month name like "january" or "jan"
+func parseMonth(s string) (time.Month, bool) {
+	for i := range English.Months {
+		if strings.EqualFold(English.Months[i], s) || strings.EqualFold(English.MonthsAbbr[i], s) {
+			return time.Month(i + 1), true
+		}
+	}
+	return 0, false
+}
+
+// offsetHoliday returns the holiday moved by days
+func offsetHoliday(fn HolidayFunc, days int) HolidayFunc {
+	return shiftHoliday(fn, func(t time.Time) time.Time {
+		return t.AddDate(0, 0, days)
+	})
+}
+
+// CalendarFile is the definition of a Calendar in a JSON or YAML file, for example
+//
+//	name: acme
+//	include: [us]
+//	weekend: [saturday, sunday]
+//	holidays:
+//	  - name: Day after Thanksgiving
+//	    rule: 4th thursday november +1
+type CalendarFile struct {
+	Name     string        `yaml:"name"`
+	Include  []string      `yaml:"include"` // registered calendars whose holidays are included
+	Weekend  []string      `yaml:"weekend"` // weekday names, Saturday and Sunday when empty
+	Holidays []HolidayRule `yaml:"holidays"`
+}
+
+// HolidayRule is a named holiday rule, see ParseRule
+type HolidayRule struct {
+	Name string `yaml:"name"`
+	Rule string `yaml:"rule"`
+}
+
+// Calendar compiles the definition into a Calendar
+func (f CalendarFile) Calendar() (Calendar, error) {
+	cal := NewCalendar(f.Name)
+	for _, name := range f.Include {
+		c, ok := LookupCalendar(name)
+		if !ok {
+			return Calendar{}, fmt.Errorf("calendar %q: unknown calendar %q to include", f.Name, name)
+		}
+		cal.Holidays = append(cal.Holidays, c.Holidays...)
+	}
+	for _, day := range f.Weekend {
+		wd, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return Calendar{}, fmt.Errorf("calendar %q: unknown weekend day %q", f.Name, day)
+		}
+		cal.Weekend = append(cal.Weekend, wd)
+	}
+	for _, h := range f.Holidays {
+		fn, err := ParseRule(h.Rule)
+		if err != nil {
+			return Calendar{}, fmt.Errorf("calendar %q: holiday %q: %w", f.Name, h.Name, err)
+		}
+		cal.Holidays = append(cal.Holidays, Holiday{Name: h.Name, Date: fn})
+	}
+	return cal, nil
+}
+
+// ParseCalendar returns the calendar defined in YAML or JSON (a subset of YAML), see CalendarFile
+func ParseCalendar(b []byte) (Calendar, error) {
+	var f CalendarFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return Calendar{}, fmt.Errorf("invalid calendar: %w", err)
+	}
+	return f.Calendar()
+}
+
+// LoadCalendar returns the calendar defined in a YAML or JSON file, see CalendarFile.
+// Use RegisterCalendar to make it available by name.
+func LoadCalendar(path string) (Calendar, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Calendar{}, err
+	}
+	return ParseCalendar(b)
+}
@@ -0,0 +1,122 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestParseRule(t *testing.T) {
+	type input struct {
+		rule string
+		year int
+	}
+	fn := func(in input) (time.Time, error) {
+		fn, err := ParseRule(in.rule)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return fn(Date(in.year, 1, 1)), nil
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"fixed":             {Input: input{"fixed 07-04", 2026}, Expected: Date(2026, 7, 4)},
+		"fixed observed":    {Input: input{"fixed 07-04 observed nearest-weekday", 2026}, Expected: Date(2026, 7, 3)},
+		"observed sunday":   {Input: input{"Fixed 07-04 Observed", 2027}, Expected: Date(2027, 7, 5)},
+		"observed monday":   {Input: input{"fixed 12-26 observed monday", 2026}, Expected: Date(2026, 12, 28)},
+		"leap day":          {Input: input{"fixed 02-29", 2023}, Expected: time.Time{}},
+		"nth weekday":       {Input: input{"3rd monday january", 2024}, Expected: Date(2024, 1, 15)},
+		"fifth weekday":     {Input: input{"5th thursday february", 2024}, Expected: Date(2024, 2, 29)},
+		"no fifth weekday":  {Input: input{"5th monday february", 2025}, Expected: time.Time{}},
+		"no fifth after":    {Input: input{"first tuesday after 5th monday february", 2025}, Expected: time.Time{}},
+		"abbreviated":       {Input: input{"first mon sep", 2024}, Expected: Date(2024, 9, 2)},
+		"last weekday":      {Input: input{"last monday may", 2024}, Expected: Date(2024, 5, 27)},
+		"easter":            {Input: input{"easter -2", 2024}, Expected: Date(2024, 3, 29)},
+		"easter monday":     {Input: input{"easter +1", 2025}, Expected: Date(2025, 4, 21)},
+		"election day":      {Input: input{"first tuesday after first monday november", 2024}, Expected: Date(2024, 11, 5)},
+		"election day 2022": {Input: input{"first tuesday after first monday november", 2022}, Expected: Date(2022, 11, 8)},
+		"day after":         {Input: input{"4th thursday november +1", 2024}, Expected: Date(2024, 11, 29)},
+		"since before":      {Input: input{"fixed 06-19 observed since 2021", 2020}, Expected: time.Time{}},
+		"since after":       {Input: input{"fixed 06-19 observed since 2021", 2022}, Expected: Date(2022, 6, 20)},
+		"until":             {Input: input{"2nd monday october until 2020", 2021}, Expected: time.Time{}},
+		"empty":             {Input: input{"", 2024}, ShouldErr: true},
+		"bad date":          {Input: input{"fixed 13-01", 2024}, ShouldErr: true},
+		"bad ordinal":       {Input: input{"sixth monday may", 2024}, ShouldErr: true},
+		"bad weekday":       {Input: input{"last moonday may", 2024}, ShouldErr: true},
+		"bad month":         {Input: input{"last monday mayo", 2024}, ShouldErr: true},
+		"bad modifier":      {Input: input{"fixed 01-01 observed weekly", 2024}, ShouldErr: true},
+		"bad offset":        {Input: input{"easter +x", 2024}, ShouldErr: true},
+		"missing year":      {Input: input{"easter since", 2024}, ShouldErr: true},
+		"short after":       {Input: input{"first tuesday after first monday", 2024}, ShouldErr: true},
+		"last after":        {Input: input{"last tuesday after first monday november", 2024}, ShouldErr: true},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestParseCalendar(t *testing.T) {
+	fn := func(in string) ([]HolidayDate, error) {
+		cal, err := ParseCalendar([]byte(in))
+		if err != nil {
+			return nil, err
+		}
+		if !cal.IsWeekend(Date(2024, 5, 3)) {
+			t.Errorf("%s: expected a Friday weekend", cal.Name)
+		}
+		return cal.HolidaysIn(2024), nil
+	}
+
+	cases := trial.Cases[string, []HolidayDate]{
+		"yaml": {
+			Input: `
+name: acme
+weekend: [friday, saturday]
+holidays:
+  - name: Good Friday
+    rule: easter -2
+  - name: Founders Day
+    rule: fixed 05-01 observed
+`,
+			Expected: []HolidayDate{{"Good Friday", Date(2024, 3, 29)}, {"Founders Day", Date(2024, 5, 1)}},
+		},
+		"json": {
+			Input:    `{"name": "acme", "weekend": ["fri", "sat"], "holidays": [{"name": "Labor Day", "rule": "first monday september"}]}`,
+			Expected: []HolidayDate{{"Labor Day", Date(2024, 9, 2)}},
+		},
+		"bad rule": {
+			Input:     `{"name": "acme", "weekend": ["fri"], "holidays": [{"name": "x", "rule": "someday"}]}`,
+			ShouldErr: true,
+		},
+		"bad weekend": {
+			Input:     `{"name": "acme", "weekend": ["caturday"]}`,
+			ShouldErr: true,
+		},
+		"bad include": {
+			Input:     `{"name": "acme", "include": ["mars"]}`,
+			ShouldErr: true,
+		},
+		"invalid": {
+			Input:     `name: [acme`,
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestLoadCalendar(t *testing.T) {
+	cal, err := LoadCalendar("testdata/acme.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, ok := cal.Holiday(Date(2024, 11, 29)); !ok || name != "Day after Thanksgiving" {
+		t.Errorf("got %q %v", name, ok)
+	}
+	if !cal.IsHoliday(Date(2024, 7, 4)) {
+		t.Error("expected the included US holidays")
+	}
+	if _, err := LoadCalendar("missing.yaml"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
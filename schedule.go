@@ -0,0 +1,270 @@
+package dates
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Roll is what a Schedule does with a run that falls on a weekend or holiday
+type Roll int
+
+const (
+	NoRoll      Roll = iota // run on the day regardless of the calendar
+	Skip                    // do not run on weekends and holidays
+	RollForward             // run on the next business day instead
+	RollBack                // run on the previous business day instead
+)
+
+const (
+	// rollWindow is how far before (or after) a time Next (or Prev) looks for runs rolled onto later (or earlier) days
+	rollWindow = 2 * OneWeek
+
+	// scheduleLimit is the number of days searched for a run, enough for Feb 29th on a business day
+	scheduleLimit = 366 * 30
+)
+
+// Schedule is a cron schedule with calendar modifiers, for example
+// "0 6 BD2 * *" runs at 06:00 on the 2nd business day of every month and
+// "0 9 * * MON" with RollForward runs every Monday or on Tuesday when Monday is a holiday.
+type Schedule struct {
+	Calendar Calendar       // weekend and holidays, a Saturday and Sunday weekend when empty
+	Roll     Roll           // what to do with runs on weekends and holidays
+	Location *time.Location // time zone of the schedule, UTC when nil
+
+	expr                          string
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+	businessDay                   int
+}
+
+var scheduleMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseSchedule parses a cron expression with the fields minute, hour, day of month, month and day of week.
+// Fields are a list of values, ranges and steps like "1,15", "MON-FRI" or "*/15".
+// The day of month may be BDn for the nth business day of the month (BD-1 is the last)
+// and the macros @yearly, @monthly, @weekly, @daily and @hourly are supported.
+func ParseSchedule(expr string) (Schedule, error) {
+	return defaultWeek.ParseSchedule(expr)
+}
+
+// ParseSchedule parses a cron expression, see ParseSchedule, @weekly runs at the start of the week
+func (d Week) ParseSchedule(expr string) (Schedule, error) {
+	s := Schedule{expr: strings.TrimSpace(expr)}
+	spec := strings.ToLower(s.expr)
+	if spec == "@weekly" {
+		spec = fmt.Sprintf("0 0 * * %d", d.weekStart)
+	} else if m, ok := scheduleMacros[spec]; ok {
+		spec = m
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: expected 5 fields", expr)
+	}
+
+	var err error
+	if s.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: minute %w", expr, err)
+	}
+	if s.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: hour %w", expr, err)
+	}
+	if s.month, err = parseCronField(fields[3], 1, 12, monthValue); err != nil {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: month %w", expr, err)
+	}
+	if s.dow, err = parseCronField(fields[4], 0, 7, weekdayValue); err != nil {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: day of week %w", expr, err)
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1 // 7 is also Sunday
+	}
+	s.domAny, s.dowAny = strings.HasPrefix(fields[2], "*"), strings.HasPrefix(fields[4], "*")
+
+	if n, ok := strings.CutPrefix(fields[2], "bd"); ok {
+		s.businessDay, err = strconv.Atoi(n)
+		if err != nil || s.businessDay == 0 || s.businessDay < -23 || s.businessDay > 23 {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: business day %q", expr, fields[2])
+		}
+		if !s.dowAny {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: a business day can not have a day of week", expr)
+		}
+		return s, nil
+	}
+	if s.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: day of month %w", expr, err)
+	}
+	return s, nil
+}
+
+// parseCronField returns the bits of the values in a cron field from min to max,
+// name looks up values like "jan" or "mon"
+func parseCronField(field string, min, max int, name func(string) (int, bool)) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		lo, hi := min, max
+		if rng != "*" {
+			first, last, isRange := strings.Cut(rng, "-")
+			var ok bool
+			if lo, ok = cronValue(first, name); !ok {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			hi = lo
+			if isRange {
+				if hi, ok = cronValue(last, name); !ok {
+					return 0, fmt.Errorf("invalid value %q", part)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q out of range %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// cronValue parses a number or a name of a cron field
+func cronValue(s string, name func(string) (int, bool)) (int, bool) {
+	if v, err := strconv.Atoi(s); err == nil {
+		return v, true
+	}
+	if name == nil {
+		return 0, false
+	}
+	return name(s)
+}
+
+func monthValue(s string) (int, bool) {
+	for i, m := range English.MonthsAbbr {
+		if strings.EqualFold(m, s) {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+func weekdayValue(s string) (int, bool) {
+	wd, ok := weekdays[s]
+	return int(wd), ok
+}
+
+// String returns the cron expression of the schedule
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// matches reports whether the day t is in the schedule before rolling.
+// Like cron, a day of month and day of week that are both set match either one.
+func (s Schedule) matches(t time.Time) bool {
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	if s.businessDay != 0 {
+		d, err := NthBusinessDayOfMonth(t, s.businessDay, s.Calendar)
+		return err == nil && t.Equal(d)
+	}
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// runDay returns the day the scheduled day t runs on after rolling, false when the run is skipped
+func (s Schedule) runDay(t time.Time) (time.Time, bool) {
+	if s.Roll == NoRoll || s.Calendar.IsBusinessDay(t) {
+		return t, true
+	}
+	switch s.Roll {
+	case RollForward:
+		return s.Calendar.NextBusinessDay(t), true
+	case RollBack:
+		return s.Calendar.PrevBusinessDay(t), true
+	}
+	return time.Time{}, false
+}
+
+func (s Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// Next returns the first run of the schedule after t, the zero time is returned when there is none
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := s.location()
+	t = t.In(loc)
+	start := Date(t.Year(), t.Month(), t.Day()).Add(-rollWindow)
+	for i := 0; i < scheduleLimit; i++ {
+		day := start.AddDate(0, 0, i)
+		if !s.matches(day) {
+			continue
+		}
+		run, ok := s.runDay(day)
+		if !ok {
+			continue
+		}
+		for h := 0; h < 24; h++ {
+			for m := 0; m < 60; m++ {
+				if s.hour&(1<<uint(h)) == 0 || s.minute&(1<<uint(m)) == 0 {
+					continue
+				}
+				if next := time.Date(run.Year(), run.Month(), run.Day(), h, m, 0, 0, loc); next.After(t) {
+					return next
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// Prev returns the last run of the schedule before t, the zero time is returned when there is none
+func (s Schedule) Prev(t time.Time) time.Time {
+	loc := s.location()
+	t = t.In(loc)
+	start := Date(t.Year(), t.Month(), t.Day()).Add(rollWindow)
+	for i := 0; i < scheduleLimit; i++ {
+		day := start.AddDate(0, 0, -i)
+		if !s.matches(day) {
+			continue
+		}
+		run, ok := s.runDay(day)
+		if !ok {
+			continue
+		}
+		for h := 23; h >= 0; h-- {
+			for m := 59; m >= 0; m-- {
+				if s.hour&(1<<uint(h)) == 0 || s.minute&(1<<uint(m)) == 0 {
+					continue
+				}
+				if prev := time.Date(run.Year(), run.Month(), run.Day(), h, m, 0, 0, loc); prev.Before(t) {
+					return prev
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
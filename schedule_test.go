@@ -0,0 +1,159 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestParseSchedule(t *testing.T) {
+	fn := func(in string) (string, error) {
+		s, err := ParseSchedule(in)
+		return s.String(), err
+	}
+
+	cases := trial.Cases[string, string]{
+		"cron":          {Input: "0 6 * * MON-FRI", Expected: "0 6 * * MON-FRI"},
+		"business day":  {Input: "0 6 BD2 * *", Expected: "0 6 BD2 * *"},
+		"macro":         {Input: "@monthly", Expected: "@monthly"},
+		"too few":       {Input: "0 6 * *", ShouldErr: true},
+		"bad minute":    {Input: "60 6 * * *", ShouldErr: true},
+		"bad step":      {Input: "*/0 6 * * *", ShouldErr: true},
+		"bad name":      {Input: "0 6 * * FUN", ShouldErr: true},
+		"bad range":     {Input: "0 6 10-5 * *", ShouldErr: true},
+		"bad bd":        {Input: "0 6 BD0 * *", ShouldErr: true},
+		"bd with dow":   {Input: "0 6 BD1 * MON", ShouldErr: true},
+		"reverse month": {Input: "0 6 * DEC-JAN *", ShouldErr: true},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+type schedule struct {
+	expr string
+	roll Roll
+	t    time.Time
+}
+
+func TestScheduleNext(t *testing.T) {
+	fn := func(in schedule) (time.Time, error) {
+		s, err := ParseSchedule(in.expr)
+		if err != nil {
+			return time.Time{}, err
+		}
+		s.Calendar, s.Roll = USFederal, in.roll
+		return s.Next(in.t), nil
+	}
+
+	cases := trial.Cases[schedule, time.Time]{
+		"2nd business day": {
+			Input:    schedule{expr: "0 6 BD2 * *", t: Date(2024, 1, 1)},
+			Expected: time.Date(2024, 1, 3, 6, 0, 0, 0, time.UTC),
+		},
+		"2nd business day after run": {
+			Input:    schedule{expr: "0 6 BD2 * *", t: time.Date(2024, 1, 3, 6, 0, 0, 0, time.UTC)},
+			Expected: time.Date(2024, 2, 2, 6, 0, 0, 0, time.UTC),
+		},
+		"last business day": {
+			Input:    schedule{expr: "0 18 BD-1 * *", t: Date(2024, 3, 1)},
+			Expected: time.Date(2024, 3, 29, 18, 0, 0, 0, time.UTC),
+		},
+		"monday no roll": {
+			Input:    schedule{expr: "0 9 * * MON", t: Date(2024, 1, 13)},
+			Expected: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		},
+		"monday roll forward": {
+			Input:    schedule{expr: "0 9 * * MON", roll: RollForward, t: Date(2024, 1, 13)},
+			Expected: time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"monday roll forward from holiday": {
+			Input:    schedule{expr: "0 9 * * MON", roll: RollForward, t: time.Date(2024, 1, 16, 8, 0, 0, 0, time.UTC)},
+			Expected: time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"monday skip": {
+			Input:    schedule{expr: "0 9 * * MON", roll: Skip, t: Date(2024, 1, 13)},
+			Expected: time.Date(2024, 1, 22, 9, 0, 0, 0, time.UTC),
+		},
+		"monday roll back": {
+			Input:    schedule{expr: "0 9 * * MON", roll: RollBack, t: Date(2024, 1, 12)},
+			Expected: time.Date(2024, 1, 12, 9, 0, 0, 0, time.UTC),
+		},
+		"every 15 minutes": {
+			Input:    schedule{expr: "*/15 * * * *", t: time.Date(2024, 1, 2, 10, 7, 0, 0, time.UTC)},
+			Expected: time.Date(2024, 1, 2, 10, 15, 0, 0, time.UTC),
+		},
+		"day of month or weekday": {
+			Input:    schedule{expr: "0 0 13 * FRI", t: Date(2024, 1, 1)},
+			Expected: Date(2024, 1, 5),
+		},
+		"leap day": {
+			Input:    schedule{expr: "0 0 29 2 *", t: Date(2024, 3, 1)},
+			Expected: Date(2028, 2, 29),
+		},
+		"weekend rolled": {
+			Input:    schedule{expr: "0 12 15 * *", roll: RollForward, t: Date(2024, 6, 1)},
+			Expected: time.Date(2024, 6, 17, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestSchedulePrev(t *testing.T) {
+	fn := func(in schedule) (time.Time, error) {
+		s, err := ParseSchedule(in.expr)
+		if err != nil {
+			return time.Time{}, err
+		}
+		s.Calendar, s.Roll = USFederal, in.roll
+		return s.Prev(in.t), nil
+	}
+
+	cases := trial.Cases[schedule, time.Time]{
+		"last business day": {
+			Input:    schedule{expr: "0 18 BD-1 * *", t: Date(2024, 1, 15)},
+			Expected: time.Date(2023, 12, 29, 18, 0, 0, 0, time.UTC),
+		},
+		"monday roll forward": {
+			Input:    schedule{expr: "0 9 * * MON", roll: RollForward, t: Date(2024, 1, 20)},
+			Expected: time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"monday roll back": {
+			Input:    schedule{expr: "0 9 * * MON", roll: RollBack, t: Date(2024, 1, 14)},
+			Expected: time.Date(2024, 1, 12, 9, 0, 0, 0, time.UTC),
+		},
+		"same day": {
+			Input:    schedule{expr: "30 8,17 * * *", t: time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)},
+			Expected: time.Date(2024, 1, 2, 8, 30, 0, 0, time.UTC),
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestScheduleLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	s, err := ParseSchedule("30 8 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Location = loc
+	got := s.Next(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	if want := time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestWeekParseSchedule(t *testing.T) {
+	s, err := NewWeek(time.Monday, time.Sunday).ParseSchedule("@weekly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Next(Date(2024, 1, 3)), Date(2024, 1, 8); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
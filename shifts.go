@@ -0,0 +1,93 @@
+package dates
+
+import (
+	"slices"
+	"time"
+)
+
+// ShiftPattern is a rotating shift schedule of crews anchored at a date.
+// Pattern is the rotation of the first crew with one letter a day, D for a day shift,
+// N for a night shift and - for a day off. Each following crew is Offset days further into the pattern.
+type ShiftPattern struct {
+	Name    string
+	Anchor  time.Time     // the first day of the pattern for the first crew
+	Pattern string        // i.e., "DDDD----NNNN----"
+	Offset  int           // days between the crews in the pattern
+	Crews   []string      // crew names
+	Hours   time.Duration // length of a shift
+}
+
+var shiftCrews = []string{"A", "B", "C", "D"}
+
+// FourOnFourOff is 4 days on and 4 days off with 12 hour shifts for 4 crews,
+// crews switch between days and nights every rotation (16 day cycle)
+func FourOnFourOff(anchor time.Time) ShiftPattern {
+	return ShiftPattern{Name: "4-on/4-off", Anchor: anchor, Pattern: "DDDD----NNNN----", Offset: 4, Crews: shiftCrews, Hours: 12 * time.Hour}
+}
+
+// DuPont is the DuPont 12 hour schedule for 4 crews, 4 nights, 3 off, 3 days, 1 off,
+// 3 nights, 3 off, 4 days and 7 off (28 day cycle)
+func DuPont(anchor time.Time) ShiftPattern {
+	return ShiftPattern{Name: "DuPont", Anchor: anchor, Pattern: "NNNN---DDD-NNN---DDDD-------", Offset: 7, Crews: shiftCrews, Hours: 12 * time.Hour}
+}
+
+// Panama is the 2-2-3 Panama 12 hour schedule for 4 crews, 2 on, 2 off, 3 on, 2 off, 2 on and 3 off
+// with days for 14 days and nights for the next 14 (28 day cycle)
+func Panama(anchor time.Time) ShiftPattern {
+	return ShiftPattern{Name: "2-2-3 Panama", Anchor: anchor, Pattern: "DD--DDD--DD---NN--NNN--NN---", Offset: 7, Crews: shiftCrews, Hours: 12 * time.Hour}
+}
+
+// shift returns the letter of the ith crew's shift on the day of t
+func (p ShiftPattern) shift(i int, t time.Time) byte {
+	if len(p.Pattern) == 0 {
+		return '-'
+	}
+	return p.Pattern[mod(DaysBetween(p.Anchor, t)+i*p.Offset, len(p.Pattern))]
+}
+
+// Shift returns the shift of a crew on the day of t, 'D' (day), 'N' (night) or
+// '-' when the crew is off or unknown
+func (p ShiftPattern) Shift(crew string, t time.Time) byte {
+	i := slices.Index(p.Crews, crew)
+	if i < 0 {
+		return '-'
+	}
+	return p.shift(i, t)
+}
+
+// IsOn reports whether a crew works on the day of t
+func (p ShiftPattern) IsOn(crew string, t time.Time) bool {
+	return p.Shift(crew, t) != '-'
+}
+
+// CrewsOn returns the crews working on the day of t in the order of Crews
+func (p ShiftPattern) CrewsOn(t time.Time) []string {
+	var crews []string
+	for i, crew := range p.Crews {
+		if p.shift(i, t) != '-' {
+			crews = append(crews, crew)
+		}
+	}
+	return crews
+}
+
+// OnDays returns the days in the range a crew works
+func (p ShiftPattern) OnDays(crew string, r Range) []time.Time {
+	var days []time.Time
+	for _, t := range r.Each() {
+		if p.IsOn(crew, t) {
+			days = append(days, t)
+		}
+	}
+	return days
+}
+
+// WorkedDays returns the number of days in the range a crew works
+func (p ShiftPattern) WorkedDays(crew string, r Range) int {
+	return len(p.OnDays(crew, r))
+}
+
+// WorkedHours returns the hours a crew works in the range, shifts are counted on the day they start
+func (p ShiftPattern) WorkedHours(crew string, r Range) time.Duration {
+	return time.Duration(p.WorkedDays(crew, r)) * p.Hours
+}
@@ -0,0 +1,71 @@
+package dates
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestShiftCoverage(t *testing.T) {
+	// every pattern has one crew on days and one on nights every day
+	fn := func(p ShiftPattern) (string, error) {
+		for _, day := range NewRange(p.Anchor, p.Anchor.AddDate(0, 0, 2*len(p.Pattern))).Each() {
+			var shifts []byte
+			for _, crew := range p.CrewsOn(day) {
+				shifts = append(shifts, p.Shift(crew, day))
+			}
+			if s := string(shifts); !strings.Contains(s, "D") || !strings.Contains(s, "N") || len(s) != 2 {
+				return day.Format(time.DateOnly) + " " + s, nil
+			}
+		}
+		return "", nil
+	}
+	anchor := Date(2024, 1, 1)
+	cases := trial.Cases[ShiftPattern, string]{
+		"4-on/4-off": {Input: FourOnFourOff(anchor)},
+		"dupont":     {Input: DuPont(anchor)},
+		"panama":     {Input: Panama(anchor)},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestCrewsOn(t *testing.T) {
+	p := Panama(Date(2024, 1, 1))
+	fn := func(in time.Time) ([]string, error) {
+		return p.CrewsOn(in), nil
+	}
+	cases := trial.Cases[time.Time, []string]{
+		"anchor":       {Input: Date(2024, 1, 1), Expected: []string{"A", "C"}},
+		"third day":    {Input: Date(2024, 1, 3), Expected: []string{"B", "D"}},
+		"next cycle":   {Input: Date(2024, 1, 29), Expected: []string{"A", "C"}},
+		"before start": {Input: Date(2023, 12, 31), Expected: []string{"B", "D"}},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestOnDays(t *testing.T) {
+	p := FourOnFourOff(Date(2024, 1, 1))
+	fn := func(crew string) ([]time.Time, error) {
+		return p.OnDays(crew, NewRange(Date(2024, 1, 1), Date(2024, 1, 10))), nil
+	}
+	cases := trial.Cases[string, []time.Time]{
+		"crew A":  {Input: "A", Expected: []time.Time{Date(2024, 1, 1), Date(2024, 1, 2), Date(2024, 1, 3), Date(2024, 1, 4), Date(2024, 1, 9), Date(2024, 1, 10)}},
+		"crew B":  {Input: "B", Expected: []time.Time{Date(2024, 1, 5), Date(2024, 1, 6), Date(2024, 1, 7), Date(2024, 1, 8)}},
+		"unknown": {Input: "E"},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWorkedHours(t *testing.T) {
+	fn := func(p ShiftPattern) (time.Duration, error) {
+		return p.WorkedHours("A", NewRange(Date(2024, 1, 1), Date(2024, 1, 28))), nil
+	}
+	cases := trial.Cases[ShiftPattern, time.Duration]{
+		"4-on/4-off": {Input: FourOnFourOff(Date(2024, 1, 1)), Expected: 16 * 12 * time.Hour},
+		"dupont":     {Input: DuPont(Date(2024, 1, 1)), Expected: 14 * 12 * time.Hour},
+		"panama":     {Input: Panama(Date(2024, 1, 1)), Expected: 14 * 12 * time.Hour},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
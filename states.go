@@ -0,0 +1,240 @@
+package dates
+
+import "slices"
+
+// usState returns the calendar of a state with the federal holidays and the state's own holidays
+func usState(code string, holidays ...Holiday) Calendar {
+	return NewCalendar(code, append(slices.Clone(USFederal.Holidays), holidays...)...)
+}
+
+// mustRule returns the holiday of a rule that is known to be valid, see ParseRule
+func mustRule(rule string) HolidayFunc {
+	fn, err := ParseRule(rule)
+	if err != nil {
+		panic(err)
+	}
+	return fn
+}
+
+var (
+	dayAfterThanksgiving = Holiday{"Day after Thanksgiving", mustRule("4th thursday november +1")}
+	goodFriday           = Holiday{"Good Friday", GoodFriday}
+	christmasEve         = Holiday{"Christmas Eve", ChristmasEve}
+	newYearsEve          = Holiday{"New Year's Eve", NewYearsEve}
+	lincolnsBirthday     = Holiday{"Lincoln's Birthday", mustRule("fixed 02-12 observed")}
+)
+
+// USStates are the holiday calendars of the 50 US states, the District of Columbia and the territories
+// keyed by their ISO 3166-2 code, each one is the federal holidays and the state's own holidays.
+// A state without its own holidays (i.e., US-AZ) has the federal holidays only.
+// They are registered by code so LookupCalendar("US-CA") returns the California calendar.
+// Holidays are on their statutory date unless the state observes them on a weekday.
+var USStates = map[string]Calendar{
+	"US-AL": usState("US-AL",
+		Holiday{"Confederate Memorial Day", mustRule("4th monday april")},
+		Holiday{"Jefferson Davis' Birthday", mustRule("first monday june")},
+	),
+	"US-AK": usState("US-AK",
+		Holiday{"Seward's Day", mustRule("last monday march")},
+		Holiday{"Alaska Day", mustRule("fixed 10-18 observed")},
+	),
+	"US-AZ": usState("US-AZ"),
+	"US-AR": usState("US-AR",
+		christmasEve,
+	),
+	"US-CA": usState("US-CA",
+		Holiday{"Cesar Chavez Day", mustRule("fixed 03-31 observed")},
+		dayAfterThanksgiving,
+	),
+	"US-CO": usState("US-CO"),
+	"US-CT": usState("US-CT",
+		lincolnsBirthday,
+		goodFriday,
+	),
+	"US-DE": usState("US-DE",
+		goodFriday,
+		dayAfterThanksgiving,
+	),
+	"US-DC": usState("US-DC",
+		Holiday{"DC Emancipation Day", mustRule("fixed 04-16 observed")},
+	),
+	"US-FL": usState("US-FL",
+		dayAfterThanksgiving,
+	),
+	"US-GA": usState("US-GA",
+		dayAfterThanksgiving,
+	),
+	"US-HI": usState("US-HI",
+		Holiday{"Prince Kuhio Day", mustRule("fixed 03-26 observed")},
+		goodFriday,
+		Holiday{"King Kamehameha Day", mustRule("fixed 06-11 observed")},
+		Holiday{"Statehood Day", mustRule("3rd friday august")},
+	),
+	"US-ID": usState("US-ID"),
+	"US-IL": usState("US-IL",
+		lincolnsBirthday,
+		dayAfterThanksgiving,
+	),
+	"US-IN": usState("US-IN",
+		goodFriday,
+		dayAfterThanksgiving,
+	),
+	"US-IA": usState("US-IA",
+		dayAfterThanksgiving,
+	),
+	"US-KS": usState("US-KS"),
+	"US-KY": usState("US-KY",
+		christmasEve,
+		newYearsEve,
+	),
+	"US-LA": usState("US-LA",
+		Holiday{"Mardi Gras", mustRule("easter -47")},
+		goodFriday,
+	),
+	"US-ME": usState("US-ME",
+		Holiday{"Patriots' Day", mustRule("3rd monday april")},
+	),
+	"US-MD": usState("US-MD",
+		Holiday{"American Indian Heritage Day", dayAfterThanksgiving.Date},
+	),
+	"US-MA": usState("US-MA",
+		Holiday{"Patriots' Day", mustRule("3rd monday april")},
+	),
+	"US-MI": usState("US-MI",
+		dayAfterThanksgiving,
+		christmasEve,
+		newYearsEve,
+	),
+	"US-MN": usState("US-MN",
+		dayAfterThanksgiving,
+	),
+	"US-MS": usState("US-MS",
+		Holiday{"Confederate Memorial Day", mustRule("last monday april")},
+	),
+	"US-MO": usState("US-MO",
+		lincolnsBirthday,
+		Holiday{"Truman Day", mustRule("fixed 05-08 observed")},
+	),
+	"US-MT": usState("US-MT"),
+	"US-NE": usState("US-NE",
+		Holiday{"Arbor Day", mustRule("last friday april")},
+		dayAfterThanksgiving,
+	),
+	"US-NV": usState("US-NV",
+		Holiday{"Nevada Day", mustRule("last friday october")},
+		Holiday{"Family Day", dayAfterThanksgiving.Date},
+	),
+	"US-NH": usState("US-NH",
+		dayAfterThanksgiving,
+	),
+	"US-NJ": usState("US-NJ",
+		goodFriday,
+	),
+	"US-NM": usState("US-NM",
+		dayAfterThanksgiving,
+	),
+	"US-NY": usState("US-NY",
+		lincolnsBirthday,
+	),
+	"US-NC": usState("US-NC",
+		goodFriday,
+		dayAfterThanksgiving,
+		christmasEve,
+	),
+	"US-ND": usState("US-ND",
+		goodFriday,
+	),
+	"US-OH": usState("US-OH"),
+	"US-OK": usState("US-OK",
+		dayAfterThanksgiving,
+	),
+	"US-OR": usState("US-OR"),
+	"US-PA": usState("US-PA"),
+	"US-RI": usState("US-RI",
+		Holiday{"Victory Day", mustRule("2nd monday august")},
+	),
+	"US-SC": usState("US-SC",
+		Holiday{"Confederate Memorial Day", mustRule("fixed 05-10")},
+		dayAfterThanksgiving,
+		christmasEve,
+		Holiday{"Day after Christmas", mustRule("fixed 12-26")},
+	),
+	"US-SD": usState("US-SD"),
+	"US-TN": usState("US-TN",
+		goodFriday,
+		dayAfterThanksgiving,
+		christmasEve,
+	),
+	"US-TX": usState("US-TX",
+		Holiday{"Texas Independence Day", mustRule("fixed 03-02")},
+		Holiday{"San Jacinto Day", mustRule("fixed 04-21")},
+		Holiday{"Lyndon Baines Johnson Day", mustRule("fixed 08-27")},
+		dayAfterThanksgiving,
+		christmasEve,
+		Holiday{"Day after Christmas", mustRule("fixed 12-26")},
+	),
+	"US-UT": usState("US-UT",
+		Holiday{"Pioneer Day", mustRule("fixed 07-24 observed")},
+	),
+	"US-VT": usState("US-VT",
+		Holiday{"Town Meeting Day", mustRule("first tuesday march")},
+		Holiday{"Bennington Battle Day", mustRule("fixed 08-16 observed")},
+	),
+	"US-VA": usState("US-VA"),
+	"US-WA": usState("US-WA",
+		Holiday{"Native American Heritage Day", dayAfterThanksgiving.Date},
+	),
+	"US-WV": usState("US-WV",
+		Holiday{"West Virginia Day", mustRule("fixed 06-20 observed")},
+		dayAfterThanksgiving,
+	),
+	"US-WI": usState("US-WI",
+		christmasEve,
+		newYearsEve,
+	),
+	"US-WY": usState("US-WY"),
+
+	// territories
+	"US-AS": usState("US-AS",
+		Holiday{"Flag Day", mustRule("fixed 04-17 observed")},
+		Holiday{"Manu'a Islands Cession Day", mustRule("fixed 07-16 observed")},
+		Holiday{"White Monday", mustRule("2nd sunday october +1")},
+	),
+	"US-GU": usState("US-GU",
+		Holiday{"Guam History and Chamorro Heritage Day", mustRule("first monday march")},
+		goodFriday,
+		Holiday{"Liberation Day", mustRule("fixed 07-21 observed")},
+		Holiday{"All Souls' Day", mustRule("fixed 11-02 observed")},
+		Holiday{"Our Lady of Camarin Day", mustRule("fixed 12-08 observed")},
+	),
+	"US-MP": usState("US-MP",
+		Holiday{"Commonwealth Constitution Day", mustRule("fixed 01-09 observed")},
+		Holiday{"Commonwealth Covenant Day", mustRule("fixed 03-24 observed")},
+		goodFriday,
+		Holiday{"Commonwealth Cultural Day", mustRule("2nd monday october")},
+		Holiday{"Citizenship Day", mustRule("fixed 11-04 observed")},
+	),
+	"US-PR": usState("US-PR",
+		Holiday{"Three Kings Day", mustRule("fixed 01-06")},
+		goodFriday,
+		Holiday{"Constitution Day", mustRule("fixed 07-25")},
+		Holiday{"Discovery of Puerto Rico", mustRule("fixed 11-19")},
+	),
+	"US-UM": usState("US-UM"),
+	"US-VI": usState("US-VI",
+		Holiday{"Three Kings Day", mustRule("fixed 01-06")},
+		Holiday{"Transfer Day", mustRule("fixed 03-31")},
+		Holiday{"Holy Thursday", mustRule("easter -3")},
+		goodFriday,
+		Holiday{"Easter Monday", mustRule("easter +1")},
+		Holiday{"Emancipation Day", mustRule("fixed 07-03")},
+		Holiday{"Liberty Day", mustRule("fixed 11-01")},
+		Holiday{"Christmas Second Day", mustRule("fixed 12-26")},
+	),
+}
+
+func init() {
+	for _, cal := range USStates {
+		RegisterCalendar(cal)
+	}
+}
@@ -0,0 +1,70 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestUSStates(t *testing.T) {
+	type input struct {
+		code string
+		t    time.Time
+	}
+	fn := func(in input) (string, error) {
+		cal, ok := LookupCalendar(in.code)
+		if !ok {
+			t.Fatalf("calendar %s not registered", in.code)
+		}
+		name, _ := cal.Holiday(in.t)
+		return name, nil
+	}
+
+	cases := trial.Cases[input, string]{
+		"federal":               {Input: input{"US-CA", Date(2024, 7, 4)}, Expected: "Independence Day"},
+		"cesar chavez":          {Input: input{"US-CA", Date(2025, 3, 31)}, Expected: "Cesar Chavez Day"},
+		"cesar chavez observed": {Input: input{"US-CA", Date(2024, 4, 1)}, Expected: "Cesar Chavez Day"},
+		"lincoln's birthday":    {Input: input{"US-NY", Date(2025, 2, 12)}, Expected: "Lincoln's Birthday"},
+		"federal only":          {Input: input{"US-WY", Date(2024, 5, 27)}, Expected: "Memorial Day"},
+		"victory day":           {Input: input{"US-RI", Date(2024, 8, 12)}, Expected: "Victory Day"},
+		"white monday":          {Input: input{"US-AS", Date(2029, 10, 15)}, Expected: "White Monday"},
+		"liberation day":        {Input: input{"US-GU", Date(2024, 7, 22)}, Expected: "Liberation Day"},
+		"transfer day":          {Input: input{"US-VI", Date(2025, 3, 31)}, Expected: "Transfer Day"},
+		"day after":             {Input: input{"us-ca", Date(2024, 11, 29)}, Expected: "Day after Thanksgiving"},
+		"patriots day":          {Input: input{"US-MA", Date(2024, 4, 15)}, Expected: "Patriots' Day"},
+		"patriots day maine":    {Input: input{"US-ME", Date(2025, 4, 21)}, Expected: "Patriots' Day"},
+		"not in other states":   {Input: input{"US-CA", Date(2024, 4, 15)}, Expected: ""},
+		"texas independence":    {Input: input{"US-TX", Date(2024, 3, 2)}, Expected: "Texas Independence Day"},
+		"mardi gras":            {Input: input{"US-LA", Date(2024, 2, 13)}, Expected: "Mardi Gras"},
+		"mardi gras 2025":       {Input: input{"US-LA", Date(2025, 3, 4)}, Expected: "Mardi Gras"},
+		"observed kamehameha":   {Input: input{"US-HI", Date(2022, 6, 10)}, Expected: "King Kamehameha Day"},
+		"seward's day":          {Input: input{"US-AK", Date(2024, 3, 25)}, Expected: "Seward's Day"},
+		"nevada day":            {Input: input{"US-NV", Date(2024, 10, 25)}, Expected: "Nevada Day"},
+		"three kings":           {Input: input{"US-PR", Date(2025, 1, 6)}, Expected: "Three Kings Day"},
+		"town meeting":          {Input: input{"US-VT", Date(2024, 3, 5)}, Expected: "Town Meeting Day"},
+		"pioneer day observed":  {Input: input{"US-UT", Date(2022, 7, 25)}, Expected: "Pioneer Day"},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestUSStatesBusinessDays(t *testing.T) {
+	// April 2024 has 22 weekdays, Patriots' Day is a holiday in Massachusetts
+	april := NewRange(FullMonth(Date(2024, 4, 1)))
+	if got := USStates["US-MA"].BusinessDays(april); got != 21 {
+		t.Errorf("US-MA got %d business days", got)
+	}
+	if got := USFederal.BusinessDays(april); got != 22 {
+		t.Errorf("us got %d business days", got)
+	}
+	// 50 states, DC and 6 territories
+	if len(USStates) != 57 {
+		t.Errorf("got %d state calendars", len(USStates))
+	}
+	for code, cal := range USStates {
+		if cal.Name != code {
+			t.Errorf("calendar %s is named %s", code, cal.Name)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+package dates
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// defaultWeek is the week used by the package level functions that need one
+var defaultWeek = Week{weekStart: StartDefault, weekEnd: EndDefault}
+
+// Strftime formats t with a C/Python strftime layout like "%Y-%m-%d" or "%G-W%V-%u".
+// The week number %W uses the default week (starting Monday), see Week.Strftime.
+//
+//	%a %A  abbreviated and full weekday name    %u  ISO weekday (1-7, Monday is 1)
+//	%b %B  abbreviated and full month name      %w  weekday (0-6, Sunday is 0)
+//	%h     same as %b                           %j  day of the year (001-366)
+//	%C     century (20)                         %U  week of the year, weeks start Sunday (00-53)
+//	%d %e  day of the month (02, " 2")          %W  week of the year, weeks start Monday (00-53)
+//	%m     month (01-12)                        %G  ISO 8601 week-based year
+//	%y %Y  year (06, 2006)                      %g  ISO 8601 week-based year without century
+//	%H %I  hour (15, 03)                        %V  ISO 8601 week number (01-53)
+//	%M %S  minute and second                    %z  UTC offset (-0700)
+//	%f     microseconds (000000)                %Z  time zone abbreviation (MST)
+//	%p     AM or PM                             %s  seconds since the Unix epoch
+//	%D %F  %m/%d/%y and %Y-%m-%d                %T %R  %H:%M:%S and %H:%M
+//	%c %x %X  date and time, date, time         %n %t %%  newline, tab, percent sign
+//
+// unknown directives are written as is.
+func Strftime(t time.Time, layout string) string {
+	return defaultWeek.Strftime(t, layout)
+}
+
+// Strptime parses s with a C/Python strftime layout, see Strftime for the directives.
+// Parts of the date that are not given default to January 1st 1900, the time is in UTC
+// unless a %z offset is given. The week number %W uses the default week (starting Monday).
+func Strptime(s, layout string) (time.Time, error) {
+	return defaultWeek.Strptime(s, layout)
+}
+
+// weekOfYear returns the week of the year of t where week 1 starts on the first
+// start weekday of the year and days before it are in week 0
+func weekOfYear(t time.Time, start time.Weekday) int {
+	offset := (int(t.Weekday()) - int(start) + 7) % 7
+	return (t.YearDay() - 1 + 7 - offset) / 7
+}
+
+// Strftime formats t with a strftime layout, see Strftime.
+// The %W week number uses the start day of the week d
+// instead of always starting on Monday.
+func (d Week) Strftime(t time.Time, layout string) string {
+	var b strings.Builder
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+		if c != '%' || i == len(layout)-1 {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch layout[i] {
+		case 'a':
+			b.WriteString(t.Format("Mon"))
+		case 'A':
+			b.WriteString(t.Format("Monday"))
+		case 'b', 'h':
+			b.WriteString(t.Format("Jan"))
+		case 'B':
+			b.WriteString(t.Format("January"))
+		case 'c':
+			b.WriteString(t.Format("Mon Jan _2 15:04:05 2006"))
+		case 'C':
+			fmt.Fprintf(&b, "%02d", t.Year()/100)
+		case 'd':
+			b.WriteString(t.Format("02"))
+		case 'D':
+			b.WriteString(t.Format("01/02/06"))
+		case 'e':
+			b.WriteString(t.Format("_2"))
+		case 'f':
+			fmt.Fprintf(&b, "%06d", t.Nanosecond()/1000)
+		case 'F':
+			b.WriteString(t.Format(time.DateOnly))
+		case 'g':
+			year, _ := t.ISOWeek()
+			fmt.Fprintf(&b, "%02d", year%100)
+		case 'G':
+			year, _ := t.ISOWeek()
+			fmt.Fprintf(&b, "%04d", year)
+		case 'H':
+			b.WriteString(t.Format("15"))
+		case 'I':
+			b.WriteString(t.Format("03"))
+		case 'j':
+			fmt.Fprintf(&b, "%03d", t.YearDay())
+		case 'm':
+			b.WriteString(t.Format("01"))
+		case 'M':
+			b.WriteString(t.Format("04"))
+		case 'n':
+			b.WriteByte('\n')
+		case 'p':
+			b.WriteString(t.Format("PM"))
+		case 'r':
+			b.WriteString(t.Format("03:04:05 PM"))
+		case 'R':
+			b.WriteString(t.Format("15:04"))
+		case 's':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'S':
+			b.WriteString(t.Format("05"))
+		case 't':
+			b.WriteByte('\t')
+		case 'T', 'X':
+			b.WriteString(t.Format(time.TimeOnly))
+		case 'u':
+			wd := int(t.Weekday())
+			if wd == 0 {
+				wd = 7
+			}
+			b.WriteString(strconv.Itoa(wd))
+		case 'U':
+			fmt.Fprintf(&b, "%02d", weekOfYear(t, time.Sunday))
+		case 'V':
+			_, week := t.ISOWeek()
+			fmt.Fprintf(&b, "%02d", week)
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'W':
+			fmt.Fprintf(&b, "%02d", weekOfYear(t, d.weekStart))
+		case 'x':
+			b.WriteString(t.Format("01/02/06"))
+		case 'y':
+			b.WriteString(t.Format("06"))
+		case 'Y':
+			fmt.Fprintf(&b, "%04d", t.Year())
+		case 'z':
+			b.WriteString(t.Format("-0700"))
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(layout[i])
+		}
+	}
+	return b.String()
+}
+
+// parsed holds the fields read by Strptime before they are resolved to a time
+type parsed struct {
+	year, month, day, yday         int
+	century, yy                    int
+	hour, min, sec, nsec           int
+	pm, hasPM                      bool
+	isoYear, isoWeek               int
+	weekday                        time.Weekday
+	weekNum                        int
+	weekStart                      time.Weekday
+	hasCentury, hasYY, hasWeekday  bool
+	hasISOYear, hasISOWeek, hasNum bool
+	hasYDay                        bool
+	loc                            *time.Location
+	unix                           *int64
+}
+
+// Strptime parses s with a strftime layout, see Strptime.
+// The %W week number uses the start day of the week d
+// instead of always starting on Monday.
+func (d Week) Strptime(s, layout string) (time.Time, error) {
+	p := parsed{year: 1900, month: 1, day: 1, loc: time.UTC}
+	value, original := s, layout
+	fail := func(format string, args ...any) (time.Time, error) {
+		return time.Time{}, fmt.Errorf("strptime %q as %q: %s", value, original, fmt.Sprintf(format, args...))
+	}
+
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+		if unicode.IsSpace(rune(c)) {
+			s = strings.TrimLeftFunc(s, unicode.IsSpace)
+			continue
+		}
+		if c != '%' || i == len(layout)-1 {
+			if len(s) == 0 || s[0] != c {
+				return fail("expected %q", c)
+			}
+			s = s[1:]
+			continue
+		}
+		i++
+		directive := layout[i]
+
+		// composite directives are expanded and parsed recursively
+		if sub, ok := compositeDirectives[directive]; ok {
+			layout = layout[:i-1] + sub + layout[i+1:]
+			i -= 2
+			continue
+		}
+
+		var err error
+		var n int
+		switch directive {
+		case 'a', 'A':
+			var idx int
+			if idx, s, err = parseName(s, English.Weekdays[:], English.WeekdaysAbbr[:]); err == nil {
+				p.weekday, p.hasWeekday = time.Weekday(idx), true
+			}
+		case 'b', 'B', 'h':
+			var idx int
+			if idx, s, err = parseName(s, English.Months[:], English.MonthsAbbr[:]); err == nil {
+				p.month = idx + 1
+			}
+		case 'C':
+			p.hasCentury = true
+			p.century, s, err = parseNumber(s, 2)
+		case 'd', 'e':
+			p.day, s, err = parseNumber(strings.TrimLeft(s, " "), 2)
+		case 'f':
+			digits := min(len(s)-len(strings.TrimLeft(s, "0123456789")), 9)
+			if n, s, err = parseNumber(s, 9); err == nil {
+				for ; digits < 9; digits++ {
+					n *= 10
+				}
+				p.nsec = n
+			}
+		case 'g':
+			if n, s, err = parseNumber(s, 2); err == nil {
+				p.isoYear, p.hasISOYear = 2000+n, true
+				if n >= 69 {
+					p.isoYear -= 100
+				}
+			}
+		case 'G':
+			p.hasISOYear = true
+			p.isoYear, s, err = parseNumber(s, 4)
+		case 'H':
+			p.hour, s, err = parseNumber(s, 2)
+		case 'I':
+			p.hour, s, err = parseNumber(s, 2)
+			if err == nil && (p.hour < 1 || p.hour > 12) {
+				return fail("hour %d out of range", p.hour)
+			}
+		case 'j':
+			p.hasYDay = true
+			p.yday, s, err = parseNumber(s, 3)
+		case 'm':
+			p.month, s, err = parseNumber(s, 2)
+		case 'M':
+			p.min, s, err = parseNumber(s, 2)
+		case 'n', 't':
+			s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		case 'p':
+			switch {
+			case len(s) >= 2 && strings.EqualFold(s[:2], "am"):
+				p.hasPM = true
+			case len(s) >= 2 && strings.EqualFold(s[:2], "pm"):
+				p.pm, p.hasPM = true, true
+			default:
+				err = fmt.Errorf("expected AM or PM")
+			}
+			if err == nil {
+				s = s[2:]
+			}
+		case 's':
+			digits := len(s) - len(strings.TrimLeft(s, "-0123456789"))
+			var sec int64
+			if sec, err = strconv.ParseInt(s[:digits], 10, 64); err == nil {
+				p.unix = &sec
+				s = s[digits:]
+			}
+		case 'S':
+			p.sec, s, err = parseNumber(s, 2)
+		case 'u':
+			if n, s, err = parseNumber(s, 1); err == nil {
+				if n < 1 || n > 7 {
+					return fail("weekday %d out of range", n)
+				}
+				p.weekday, p.hasWeekday = time.Weekday(n%7), true
+			}
+		case 'U', 'W':
+			p.hasNum = true
+			p.weekStart = time.Sunday
+			if directive == 'W' {
+				p.weekStart = d.weekStart
+			}
+			p.weekNum, s, err = parseNumber(s, 2)
+		case 'V':
+			p.hasISOWeek = true
+			p.isoWeek, s, err = parseNumber(s, 2)
+		case 'w':
+			if n, s, err = parseNumber(s, 1); err == nil {
+				if n > 6 {
+					return fail("weekday %d out of range", n)
+				}
+				p.weekday, p.hasWeekday = time.Weekday(n), true
+			}
+		case 'y':
+			p.hasYY = true
+			p.yy, s, err = parseNumber(s, 2)
+		case 'Y':
+			p.year, s, err = parseNumber(s, 4)
+		case 'z':
+			p.loc, s, err = parseOffset(s)
+		case 'Z':
+			name := strings.TrimLeftFunc(s, unicode.IsLetter)
+			if abbr := s[:len(s)-len(name)]; abbr == "UTC" || abbr == "GMT" || abbr == "Z" {
+				p.loc = time.UTC
+			}
+			s = name
+		case '%':
+			if len(s) == 0 || s[0] != '%' {
+				err = fmt.Errorf("expected %%")
+			} else {
+				s = s[1:]
+			}
+		default:
+			return fail("unsupported directive %%%c", directive)
+		}
+		if err != nil {
+			return fail("%%%c: %v", directive, err)
+		}
+	}
+	if s != "" {
+		return fail("extra text %q", s)
+	}
+
+	t, err := p.resolve()
+	if err != nil {
+		return fail("%v", err)
+	}
+	return t, nil
+}
+
+// compositeDirectives are directives that are shorthand for several others
+var compositeDirectives = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+// resolve converts the parsed fields into a time
+func (p parsed) resolve() (time.Time, error) {
+	if p.unix != nil {
+		return time.Unix(*p.unix, 0).In(p.loc), nil
+	}
+	switch {
+	case p.hasCentury && p.hasYY:
+		p.year = p.century*100 + p.yy
+	case p.hasCentury:
+		p.year = p.century * 100
+	case p.hasYY:
+		// same pivot as POSIX, 69-99 are 1969-1999 and 00-68 are 2000-2068
+		p.year = 2000 + p.yy
+		if p.yy >= 69 {
+			p.year -= 100
+		}
+	}
+	if p.hasPM {
+		p.hour %= 12
+		if p.pm {
+			p.hour += 12
+		}
+	}
+
+	var date time.Time
+	switch {
+	case p.hasISOWeek:
+		year := p.isoYear
+		if !p.hasISOYear {
+			year = p.year
+		}
+		wd := p.weekday
+		if !p.hasWeekday {
+			wd = time.Monday
+		}
+		// monday of ISO week 1 is the monday of the week with January 4th
+		jan4 := Date(year, time.January, 4)
+		week1 := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		date = week1.AddDate(0, 0, (p.isoWeek-1)*7+(int(wd)+6)%7)
+		if y, w := date.ISOWeek(); y != year || w != p.isoWeek {
+			return time.Time{}, fmt.Errorf("ISO week %d is not in %d", p.isoWeek, year)
+		}
+	case p.hasYDay:
+		date = Date(p.year, time.January, p.yday)
+		if p.yday < 1 || date.Year() != p.year {
+			return time.Time{}, fmt.Errorf("day of year %d out of range", p.yday)
+		}
+	case p.hasNum && p.hasWeekday:
+		jan1 := Date(p.year, time.January, 1)
+		week1 := jan1.AddDate(0, 0, (int(p.weekStart)-int(jan1.Weekday())+7)%7)
+		date = week1.AddDate(0, 0, (p.weekNum-1)*7+(int(p.weekday)-int(p.weekStart)+7)%7)
+		if date.Year() != p.year {
+			return time.Time{}, fmt.Errorf("week %d out of range", p.weekNum)
+		}
+	default:
+		if p.month < 1 || p.month > 12 {
+			return time.Time{}, fmt.Errorf("month %d out of range", p.month)
+		}
+		date = Date(p.year, time.Month(p.month), p.day)
+		if p.day < 1 || date.Month() != time.Month(p.month) {
+			return time.Time{}, fmt.Errorf("day %d out of range", p.day)
+		}
+	}
+
+	if p.hour > 23 || p.min > 59 || p.sec > 60 {
+		return time.Time{}, fmt.Errorf("time %02d:%02d:%02d out of range", p.hour, p.min, p.sec)
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), p.hour, p.min, p.sec, p.nsec, p.loc), nil
+}
+
+// parseNumber reads up to width digits from the start of s
+func parseNumber(s string, width int) (int, string, error) {
+	i := 0
+	for i < len(s) && i < width && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return 0, s, fmt.Errorf("expected a number")
+	}
+	n, err := strconv.Atoi(s[:i])
+	return n, s[i:], err
+}
+
+// parseName reads a full or abbreviated name from the start of s without case
+func parseName(s string, full, abbr []string) (int, string, error) {
+	for _, names := range [][]string{full, abbr} {
+		for i, name := range names {
+			if len(s) >= len(name) && strings.EqualFold(s[:len(name)], name) {
+				return i, s[len(name):], nil
+			}
+		}
+	}
+	return 0, s, fmt.Errorf("unknown name")
+}
+
+// parseOffset reads a UTC offset like Z, +07, -0700 or -07:00
+func parseOffset(s string) (*time.Location, string, error) {
+	if strings.HasPrefix(s, "Z") {
+		return time.UTC, s[1:], nil
+	}
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return nil, s, fmt.Errorf("expected a UTC offset")
+	}
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	hours, rest, err := parseNumber(s[1:], 2)
+	if err != nil {
+		return nil, s, err
+	}
+	rest = strings.TrimPrefix(rest, ":")
+	mins := 0
+	if len(rest) >= 2 && rest[0] >= '0' && rest[0] <= '9' {
+		if mins, rest, err = parseNumber(rest, 2); err != nil {
+			return nil, s, err
+		}
+	}
+	offset := sign * (hours*3600 + mins*60)
+	if offset == 0 {
+		return time.UTC, rest, nil
+	}
+	return time.FixedZone("", offset), rest, nil
+}
@@ -0,0 +1,145 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+func TestStrftime(t *testing.T) {
+	type input struct {
+		date   time.Time
+		layout string
+	}
+	fn := func(in input) (string, error) {
+		return Strftime(in.date, in.layout), nil
+	}
+
+	date := time.Date(2024, 2, 6, 15, 4, 5, 123456789, time.UTC) // Tuesday
+	cases := trial.Cases[input, string]{
+		"iso date": {
+			Input:    input{date, "%Y-%m-%d"},
+			Expected: "2024-02-06",
+		},
+		"iso week": {
+			Input:    input{date, "%G-W%V-%u"},
+			Expected: "2024-W06-2",
+		},
+		"iso week previous year": {
+			Input:    input{Date(2021, 1, 3), "%G-W%V-%u %Y"},
+			Expected: "2020-W53-7 2021",
+		},
+		"names": {
+			Input:    input{date, "%a %A %b %B %h"},
+			Expected: "Tue Tuesday Feb February Feb",
+		},
+		"day of year": {
+			Input:    input{Date(2024, 12, 31), "%j"},
+			Expected: "366",
+		},
+		"week numbers": {
+			Input:    input{Date(2024, 1, 6), "%U %W"}, // Saturday
+			Expected: "00 01",
+		},
+		"time": {
+			Input:    input{date, "%H:%M:%S.%f %I %p %T"},
+			Expected: "15:04:05.123456 03 PM 15:04:05",
+		},
+		"composites": {
+			Input:    input{date, "%D %F %c"},
+			Expected: "02/06/24 2024-02-06 Tue Feb  6 15:04:05 2024",
+		},
+		"escapes": {
+			Input:    input{date, "100%% %Q"},
+			Expected: "100% %Q",
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWeekStrftime(t *testing.T) {
+	d := NewWeek(time.Sunday, time.Saturday)
+	fn := func(in time.Time) (string, error) {
+		return d.Strftime(in, "%W"), nil
+	}
+
+	cases := trial.Cases[time.Time, string]{
+		"first sunday": {Input: Date(2024, 1, 7), Expected: "01"},
+		"before":       {Input: Date(2024, 1, 6), Expected: "00"},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestStrptime(t *testing.T) {
+	type input struct {
+		value  string
+		layout string
+	}
+	fn := func(in input) (time.Time, error) {
+		return Strptime(in.value, in.layout)
+	}
+
+	cases := trial.Cases[input, time.Time]{
+		"iso date": {
+			Input:    input{"2024-02-06", "%Y-%m-%d"},
+			Expected: Date(2024, 2, 6),
+		},
+		"iso week": {
+			Input:    input{"2024-W06-2", "%G-W%V-%u"},
+			Expected: Date(2024, 2, 6),
+		},
+		"iso week previous year": {
+			Input:    input{"2020-W53-7", "%G-W%V-%u"},
+			Expected: Date(2021, 1, 3),
+		},
+		"iso week monday": {
+			Input:    input{"2025-W01", "%G-W%V"},
+			Expected: Date(2024, 12, 30),
+		},
+		"day of year": {
+			Input:    input{"2024 366", "%Y %j"},
+			Expected: Date(2024, 12, 31),
+		},
+		"sunday week number": {
+			Input:    input{"2024 01 0", "%Y %U %w"},
+			Expected: Date(2024, 1, 7),
+		},
+		"monday week number": {
+			Input:    input{"2024 01 1", "%Y %W %u"},
+			Expected: Date(2024, 1, 1),
+		},
+		"names and time": {
+			Input:    input{"Tue, 06 feb 2024 03:04:05 pm", "%a, %d %b %Y %I:%M:%S %p"},
+			Expected: time.Date(2024, 2, 6, 15, 4, 5, 0, time.UTC),
+		},
+		"fraction and offset": {
+			Input:    input{"2024-02-06T15:04:05.25+00:00", "%Y-%m-%dT%H:%M:%S.%f%z"},
+			Expected: time.Date(2024, 2, 6, 15, 4, 5, 250000000, time.UTC),
+		},
+		"two digit year": {
+			Input:    input{"02/06/24", "%D"},
+			Expected: Date(2024, 2, 6),
+		},
+		"invalid day": {
+			Input:     input{"2023-02-29", "%Y-%m-%d"},
+			ShouldErr: true,
+		},
+		"invalid iso week": {
+			Input:     input{"2024-W53", "%G-W%V"},
+			ShouldErr: true,
+		},
+		"extra text": {
+			Input:     input{"2024-02-06 x", "%Y-%m-%d"},
+			ShouldErr: true,
+		},
+		"literal mismatch": {
+			Input:     input{"2024/02/06", "%Y-%m-%d"},
+			ShouldErr: true,
+		},
+	}
+
+	trial.New(fn, cases).SubTest(t)
+}
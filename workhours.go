@@ -0,0 +1,151 @@
+package dates
+
+import (
+	"slices"
+	"time"
+)
+
+// Interval is a span of working time in a day as the time of day it starts and ends, i.e., 9h to 17h
+type Interval struct {
+	Start, End time.Duration
+}
+
+// WorkingHours is a business hours calendar with the working intervals of each weekday,
+// breaks like lunch are the gaps between intervals. The holidays of the Calendar are closed.
+type WorkingHours struct {
+	Calendar Calendar       // holidays the business is closed
+	Location *time.Location // time zone of the hours, UTC when nil
+	Hours    [7][]Interval  // working intervals indexed by time.Weekday in order
+}
+
+// NewWorkingHours returns working hours with the same intervals on every day that is not a weekend day of cal,
+// i.e., NewWorkingHours(USFederal, loc, Interval{9 * time.Hour, 12 * time.Hour}, Interval{13 * time.Hour, 17 * time.Hour})
+// is 9 to 5 with an hour for lunch
+func NewWorkingHours(cal Calendar, loc *time.Location, hours ...Interval) WorkingHours {
+	h := WorkingHours{Calendar: cal, Location: loc}
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if !cal.IsWeekend(Date(2024, time.January, 7+int(wd))) { // Jan 7th, 2024 is a Sunday
+			h.Hours[wd] = slices.Clone(hours)
+		}
+	}
+	return h
+}
+
+func (h WorkingHours) location() *time.Location {
+	if h.Location == nil {
+		return time.UTC
+	}
+	return h.Location
+}
+
+// intervals returns the working intervals of day as times, none on a holiday
+func (h WorkingHours) intervals(day time.Time) [][2]time.Time {
+	hours := h.Hours[day.Weekday()]
+	if len(hours) == 0 || h.Calendar.IsHoliday(day) {
+		return nil
+	}
+	loc := h.location()
+	at := func(d time.Duration) time.Time {
+		// the nanoseconds are normalized to the wall clock so DST changes do not move the hours
+		return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, int(d), loc)
+	}
+	spans := make([][2]time.Time, 0, len(hours))
+	for _, iv := range hours {
+		spans = append(spans, [2]time.Time{at(iv.Start), at(iv.End)})
+	}
+	return spans
+}
+
+// IsOpen reports whether t is in working hours
+func (h WorkingHours) IsOpen(t time.Time) bool {
+	t = t.In(h.location())
+	for _, span := range h.intervals(Day(t)) {
+		if !t.Before(span[0]) && t.Before(span[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddWorkingDuration returns t with d working time added (use a negative value to subtract),
+// i.e., 8 hours after 15:00 on a Friday with 9 to 5 hours is 14:00 on Monday.
+// The zero time is returned when there are no working hours to add d to.
+func (h WorkingHours) AddWorkingDuration(t time.Time, d time.Duration) time.Time {
+	t = t.In(h.location())
+	if d == 0 {
+		return t
+	}
+	day := Day(t)
+	for i := 0; i < scheduleLimit; i++ {
+		spans := h.intervals(day)
+		if d > 0 {
+			for _, span := range spans {
+				start := span[0]
+				if t.After(start) {
+					start = t
+				}
+				if available := span[1].Sub(start); available > 0 {
+					if d <= available {
+						return start.Add(d)
+					}
+					d -= available
+				}
+			}
+			day = day.AddDate(0, 0, 1)
+			continue
+		}
+		for j := len(spans) - 1; j >= 0; j-- {
+			end := spans[j][1]
+			if t.Before(end) {
+				end = t
+			}
+			if available := end.Sub(spans[j][0]); available > 0 {
+				if -d <= available {
+					return end.Add(d)
+				}
+				d += available
+			}
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return time.Time{}
+}
+
+// WorkingDurationBetween returns the working time from a to b,
+// the value is negative when b is before a
+func (h WorkingHours) WorkingDurationBetween(a, b time.Time) time.Duration {
+	if b.Before(a) {
+		return -h.WorkingDurationBetween(b, a)
+	}
+	loc := h.location()
+	a, b = a.In(loc), b.In(loc)
+	var total time.Duration
+	for day := Day(a); !day.After(Day(b)); day = day.AddDate(0, 0, 1) {
+		for _, span := range h.intervals(day) {
+			start, end := span[0], span[1]
+			if a.After(start) {
+				start = a
+			}
+			if b.Before(end) {
+				end = b
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+	return total
+}
+
+// WorkingWeek returns the working time in the week of t
+func WorkingWeek(t time.Time, h WorkingHours) time.Duration {
+	return defaultWeek.WorkingWeek(t, h)
+}
+
+// WorkingWeek returns the working time in the week of t, i.e., 40 hours for a 9 to 5 week without holidays
+func (d Week) WorkingWeek(t time.Time, h WorkingHours) time.Duration {
+	loc := h.location()
+	start := d.StartOfWeek(t)
+	from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	return h.WorkingDurationBetween(from, from.AddDate(0, 0, 7))
+}
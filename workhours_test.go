@@ -0,0 +1,97 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydronica/trial"
+)
+
+var nineToFive = NewWorkingHours(USFederal, time.UTC,
+	Interval{9 * time.Hour, 12 * time.Hour},
+	Interval{13 * time.Hour, 17 * time.Hour},
+)
+
+func at(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+}
+
+func TestIsOpen(t *testing.T) {
+	fn := func(in time.Time) (bool, error) {
+		return nineToFive.IsOpen(in), nil
+	}
+	cases := trial.Cases[time.Time, bool]{
+		"open":        {Input: at(2024, 2, 6, 9, 0), Expected: true},
+		"before open": {Input: at(2024, 2, 6, 8, 59), Expected: false},
+		"lunch":       {Input: at(2024, 2, 6, 12, 30), Expected: false},
+		"closed":      {Input: at(2024, 2, 6, 17, 0), Expected: false},
+		"weekend":     {Input: at(2024, 2, 3, 10, 0), Expected: false},
+		"holiday":     {Input: at(2024, 1, 15, 10, 0), Expected: false},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestAddWorkingDuration(t *testing.T) {
+	type input struct {
+		t time.Time
+		d time.Duration
+	}
+	fn := func(in input) (time.Time, error) {
+		return nineToFive.AddWorkingDuration(in.t, in.d), nil
+	}
+	cases := trial.Cases[input, time.Time]{
+		"same day":     {Input: input{at(2024, 2, 6, 9, 0), 2 * time.Hour}, Expected: at(2024, 2, 6, 11, 0)},
+		"over lunch":   {Input: input{at(2024, 2, 6, 11, 0), 2 * time.Hour}, Expected: at(2024, 2, 6, 14, 0)},
+		"end of day":   {Input: input{at(2024, 2, 6, 9, 0), 7 * time.Hour}, Expected: at(2024, 2, 6, 17, 0)},
+		"overnight":    {Input: input{at(2024, 2, 6, 15, 0), 3 * time.Hour}, Expected: at(2024, 2, 7, 10, 0)},
+		"weekend":      {Input: input{at(2024, 2, 2, 15, 0), 8 * time.Hour}, Expected: at(2024, 2, 5, 16, 0)},
+		"after hours":  {Input: input{at(2024, 2, 6, 20, 0), time.Hour}, Expected: at(2024, 2, 7, 10, 0)},
+		"holiday":      {Input: input{at(2024, 1, 12, 16, 0), 2 * time.Hour}, Expected: at(2024, 1, 16, 10, 0)},
+		"subtract":     {Input: input{at(2024, 2, 6, 10, 0), -2 * time.Hour}, Expected: at(2024, 2, 5, 16, 0)},
+		"subtract end": {Input: input{at(2024, 2, 6, 14, 0), -time.Hour}, Expected: at(2024, 2, 6, 13, 0)},
+		"zero":         {Input: input{at(2024, 2, 3, 10, 0), 0}, Expected: at(2024, 2, 3, 10, 0)},
+	}
+	trial.New(fn, cases).SubTest(t)
+
+	if got := (WorkingHours{}).AddWorkingDuration(at(2024, 2, 6, 9, 0), time.Hour); !got.IsZero() {
+		t.Errorf("no working hours got %v", got)
+	}
+}
+
+func TestAddWorkingDurationDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	h := NewWorkingHours(USFederal, loc, Interval{9 * time.Hour, 17 * time.Hour})
+	// clocks change on Sunday March 10th 2024
+	got := h.AddWorkingDuration(time.Date(2024, 3, 8, 16, 0, 0, 0, loc), 2*time.Hour)
+	if want := time.Date(2024, 3, 11, 10, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestWorkingDurationBetween(t *testing.T) {
+	fn := func(in Range) (time.Duration, error) {
+		return nineToFive.WorkingDurationBetween(in.Start, in.End), nil
+	}
+	cases := trial.Cases[Range, time.Duration]{
+		"same day":  {Input: NewRange(at(2024, 2, 6, 10, 0), at(2024, 2, 6, 15, 30)), Expected: 4*time.Hour + 30*time.Minute},
+		"overnight": {Input: NewRange(at(2024, 2, 6, 16, 0), at(2024, 2, 7, 10, 0)), Expected: 2 * time.Hour},
+		"weekend":   {Input: NewRange(at(2024, 2, 2, 0, 0), at(2024, 2, 5, 23, 0)), Expected: 14 * time.Hour},
+		"holiday":   {Input: NewRange(at(2024, 1, 15, 0, 0), at(2024, 1, 16, 0, 0)), Expected: 0},
+		"reversed":  {Input: NewRange(at(2024, 2, 6, 11, 0), at(2024, 2, 6, 9, 0)), Expected: -2 * time.Hour},
+	}
+	trial.New(fn, cases).SubTest(t)
+}
+
+func TestWorkingWeek(t *testing.T) {
+	fn := func(in time.Time) (time.Duration, error) {
+		return WorkingWeek(in, nineToFive), nil
+	}
+	cases := trial.Cases[time.Time, time.Duration]{
+		"full week":    {Input: Date(2024, 2, 7), Expected: 35 * time.Hour},
+		"with holiday": {Input: Date(2024, 1, 17), Expected: 28 * time.Hour},
+	}
+	trial.New(fn, cases).SubTest(t)
+}